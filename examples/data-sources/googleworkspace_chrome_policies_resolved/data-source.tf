@@ -0,0 +1,18 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+data "googleworkspace_org_unit" "example" {
+  org_unit_path = "/Engineering"
+}
+
+data "googleworkspace_chrome_policies_resolved" "example" {
+  org_unit_id          = data.googleworkspace_org_unit.example.id
+  policy_schema_filter = "chrome.users.*"
+}
+
+output "inherited_policies" {
+  value = [
+    for p in data.googleworkspace_chrome_policies_resolved.example.resolved_policies : p.schema_name
+    if length(p.source_key) > 0 && p.source_key[0].target_resource != p.target_key[0].target_resource
+  ]
+}
@@ -0,0 +1,10 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+data "googleworkspace_chrome_policy_schemas" "printing" {
+  filter = "category:Printing"
+}
+
+output "printing_schema_names" {
+  value = data.googleworkspace_chrome_policy_schemas.printing.schemas[*].schema_name
+}
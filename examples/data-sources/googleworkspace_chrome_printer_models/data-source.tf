@@ -0,0 +1,10 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+data "googleworkspace_chrome_printer_models" "brother" {
+  filter = "manufacturer:Brother"
+}
+
+output "brother_models" {
+  value = data.googleworkspace_chrome_printer_models.brother.printer_models[*].make_and_model
+}
@@ -0,0 +1,8 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+data "googleworkspace_data_transfer_applications" "all" {}
+
+output "application_names" {
+  value = data.googleworkspace_data_transfer_applications.all.applications[*].name
+}
@@ -0,0 +1,10 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+data "googleworkspace_user_asps" "dwight" {
+  user_id = "dwight.schrute@example.com"
+}
+
+output "asp_names" {
+  value = data.googleworkspace_user_asps.dwight.asps[*].name
+}
@@ -0,0 +1,10 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+data "googleworkspace_user_tokens" "dwight" {
+  user_id = "dwight.schrute@example.com"
+}
+
+output "third_party_apps" {
+  value = data.googleworkspace_user_tokens.dwight.tokens[*].display_text
+}
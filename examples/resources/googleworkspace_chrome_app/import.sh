@@ -0,0 +1,5 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+# Import using {org_unit_id}/{app_id}
+terraform import googleworkspace_chrome_app.slides 03ph8a2z1enx4lx/chrome:aapocclcgogkmnckokdopfmhonfmgoek
@@ -0,0 +1,21 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "googleworkspace_org_unit" "example" {
+  name                 = "example"
+  parent_org_unit_path = "/"
+}
+
+resource "googleworkspace_chrome_app" "slides" {
+  org_unit_id  = googleworkspace_org_unit.example.id
+  app_id       = "chrome:aapocclcgogkmnckokdopfmhonfmgoek"
+  install_type = "FORCED"
+  pinned       = true
+
+  blocked_permissions = ["geolocation"]
+  blocked_hosts       = ["*://*.example.com"]
+
+  managed_configuration = jsonencode({
+    defaultView = "grid"
+  })
+}
@@ -0,0 +1,24 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "googleworkspace_org_unit" "example" {
+  name                 = "example"
+  parent_org_unit_path = "/"
+}
+
+resource "googleworkspace_chrome_policy_file" "wallpaper" {
+  policy_field = "chrome.users.Wallpaper.wallpaperImage"
+  source       = "${path.module}/wallpaper.jpg"
+}
+
+resource "googleworkspace_chrome_policy" "wallpaper" {
+  org_unit_id = googleworkspace_org_unit.example.id
+  policies {
+    schema_name = "chrome.users.Wallpaper"
+    schema_values = {
+      wallpaperImage = jsonencode({
+        downloadUri = googleworkspace_chrome_policy_file.wallpaper.download_uri
+      })
+    }
+  }
+}
@@ -0,0 +1,14 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "googleworkspace_org_unit" "office" {
+  name                 = "office"
+  parent_org_unit_path = "/"
+}
+
+resource "googleworkspace_chrome_print_server" "office" {
+  org_unit_id  = googleworkspace_org_unit.office.id
+  display_name = "Office Print Server"
+  description  = "CUPS server for the office network"
+  uri          = "ipps://print.example.com:443"
+}
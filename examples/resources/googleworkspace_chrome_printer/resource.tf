@@ -0,0 +1,26 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "googleworkspace_org_unit" "office" {
+  name                 = "office"
+  parent_org_unit_path = "/"
+}
+
+data "googleworkspace_chrome_printer_models" "brother" {
+  filter = "manufacturer:Brother"
+}
+
+resource "googleworkspace_chrome_printer" "lobby" {
+  org_unit_id    = googleworkspace_org_unit.office.id
+  display_name   = "Lobby Printer"
+  description    = "Ground floor, next to reception"
+  uri            = "ipp://192.168.1.10:631/ipp/print"
+  make_and_model = data.googleworkspace_chrome_printer_models.brother.printer_models[0].make_and_model
+}
+
+resource "googleworkspace_chrome_printer" "driverless" {
+  org_unit_id           = googleworkspace_org_unit.office.id
+  display_name          = "Second Floor Printer"
+  uri                   = "ipps://192.168.1.11:631/ipp/print"
+  use_driverless_config = true
+}
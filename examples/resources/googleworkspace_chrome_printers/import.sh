@@ -0,0 +1,5 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+# imports all the printers of the org unit
+terraform import googleworkspace_chrome_printers.office 03ph8a2z1enx2ab
@@ -0,0 +1,4 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+terraform import googleworkspace_data_transfer.dwight_to_jim AKrEtIYG88oEb9cDVhUvNA9w0cDGv7c9OeUrVEBEGmUrGPBdrL5cTX2hcOiFNq1nnMHzxbJB1xtb
@@ -0,0 +1,31 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+data "googleworkspace_data_transfer_applications" "all" {}
+
+locals {
+  applications = { for app in data.googleworkspace_data_transfer_applications.all.applications : app.name => app.id }
+}
+
+resource "googleworkspace_data_transfer" "dwight_to_jim" {
+  old_owner_user_id = "dwight.schrute@example.com"
+  new_owner_user_id = "jim.halpert@example.com"
+
+  application_data_transfers {
+    application_id = local.applications["Drive and Docs"]
+
+    application_transfer_params {
+      key   = "PRIVACY_LEVEL"
+      value = ["SHARED", "PRIVATE"]
+    }
+  }
+
+  application_data_transfers {
+    application_id = local.applications["Calendar"]
+
+    application_transfer_params {
+      key   = "RELEASE_RESOURCES"
+      value = ["TRUE"]
+    }
+  }
+}
@@ -1,4 +1,7 @@
 # Copyright (c) HashiCorp, Inc.
 # SPDX-License-Identifier: MPL-2.0
 
-terraform import googleworkspace_group.sales 01abcde23fg4h5i
\ No newline at end of file
+terraform import googleworkspace_group.sales 01abcde23fg4h5i
+
+# or by email or alias
+terraform import googleworkspace_group.sales sales@example.com
@@ -1,4 +1,7 @@
 # Copyright (c) HashiCorp, Inc.
 # SPDX-License-Identifier: MPL-2.0
 
-terraform import googleworkspace_org_unit.org "id:01ab2c3d4efg56h"
\ No newline at end of file
+terraform import googleworkspace_org_unit.org "id:01ab2c3d4efg56h"
+
+# or by path
+terraform import googleworkspace_org_unit.org /sales/east
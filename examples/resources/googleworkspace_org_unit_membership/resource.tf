@@ -0,0 +1,30 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "googleworkspace_org_unit" "sales" {
+  name                 = "sales"
+  parent_org_unit_path = "/"
+}
+
+resource "googleworkspace_org_unit_membership" "sales" {
+  org_unit_path = googleworkspace_org_unit.sales.org_unit_path
+  query         = "orgDepartment='Sales'"
+
+  removal_org_unit_path = "/"
+  concurrency           = 25
+  max_moves             = 100
+}
+
+resource "googleworkspace_org_unit" "interns" {
+  name                 = "interns"
+  parent_org_unit_path = "/"
+}
+
+resource "googleworkspace_org_unit_membership" "interns" {
+  org_unit_path = googleworkspace_org_unit.interns.org_unit_path
+  users = [
+    "ryan.howard@example.com",
+    "erin.hannon@example.com",
+  ]
+  authoritative = true
+}
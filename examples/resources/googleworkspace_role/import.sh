@@ -1,4 +1,7 @@
 # Copyright (c) HashiCorp, Inc.
 # SPDX-License-Identifier: MPL-2.0
 
-terraform import googleworkspace_role.admin 12345678901234567
\ No newline at end of file
+terraform import googleworkspace_role.admin 12345678901234567
+
+# or by name
+terraform import googleworkspace_role.admin "Help Desk Admin"
@@ -1,4 +1,7 @@
 # Copyright (c) HashiCorp, Inc.
 # SPDX-License-Identifier: MPL-2.0
 
-terraform import googleworkspace_role_assignment.dwight 12345678901234567
\ No newline at end of file
+terraform import googleworkspace_role_assignment.dwight 12345678901234567
+
+# or by role name and assignee email
+terraform import googleworkspace_role_assignment.dwight "_GROUPS_ADMIN_ROLE/dwight.schrute@example.com"
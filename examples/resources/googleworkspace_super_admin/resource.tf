@@ -0,0 +1,18 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "googleworkspace_user" "dwight" {
+  primary_email = "dwight.schrute@example.com"
+  password      = "34819d7beeabb9260a5c854bc85b3e44"
+  hash_function = "MD5"
+
+  name {
+    family_name = "Schrute"
+    given_name  = "Dwight"
+  }
+}
+
+resource "googleworkspace_super_admin" "dwight" {
+  user_id     = googleworkspace_user.dwight.id
+  description = "Break glass account for the Scranton branch, approved in SEC-1234"
+}
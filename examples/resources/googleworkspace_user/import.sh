@@ -1,4 +1,7 @@
 # Copyright (c) HashiCorp, Inc.
 # SPDX-License-Identifier: MPL-2.0
 
-terraform import googleworkspace_user.dwight 123456789012345678901
\ No newline at end of file
+terraform import googleworkspace_user.dwight 123456789012345678901
+
+# or by primary email or alias
+terraform import googleworkspace_user.dwight dwight.schrute@example.com
@@ -0,0 +1,23 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "googleworkspace_user" "dwight" {
+  primary_email = "dwight.schrute@example.com"
+  password      = "34819d7beeabb9260a5c854bc85b3e44"
+  hash_function = "MD5"
+
+  name {
+    family_name = "Schrute"
+    given_name  = "Dwight"
+  }
+
+  # aliases are managed with googleworkspace_user_alias
+  lifecycle {
+    ignore_changes = [aliases]
+  }
+}
+
+resource "googleworkspace_user_alias" "support" {
+  user_id = googleworkspace_user.dwight.id
+  alias   = "support@example.com"
+}
@@ -0,0 +1,22 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "googleworkspace_user" "dwight" {
+  primary_email = "dwight.schrute@example.com"
+  password      = "34819d7beeabb9260a5c854bc85b3e44"
+  hash_function = "MD5"
+  suspended     = true
+
+  name {
+    family_name = "Schrute"
+    given_name  = "Dwight"
+  }
+}
+
+# Revoke all third-party access of the offboarded user
+resource "googleworkspace_user_credentials_revocation" "dwight" {
+  user_id                        = googleworkspace_user.dwight.id
+  revoke_oauth_tokens            = true
+  revoke_asps                    = true
+  turn_off_two_step_verification = true
+}
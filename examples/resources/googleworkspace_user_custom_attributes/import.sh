@@ -0,0 +1,4 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+terraform import googleworkspace_user_custom_attributes.employee users/dwight.schrute@example.com/customSchemas/employee-data
@@ -0,0 +1,42 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "googleworkspace_schema" "employee" {
+  schema_name = "employee-data"
+
+  fields {
+    field_name = "employee-number"
+    field_type = "INT64"
+  }
+
+  fields {
+    field_name = "cost-center"
+    field_type = "STRING"
+  }
+}
+
+resource "googleworkspace_user" "dwight" {
+  primary_email = "dwight.schrute@example.com"
+  password      = "34819d7beeabb9260a5c854bc85b3e44"
+  hash_function = "MD5"
+
+  name {
+    family_name = "Schrute"
+    given_name  = "Dwight"
+  }
+
+  # custom schema values are managed with googleworkspace_user_custom_attributes
+  lifecycle {
+    ignore_changes = [custom_schemas]
+  }
+}
+
+resource "googleworkspace_user_custom_attributes" "employee" {
+  user_id     = googleworkspace_user.dwight.id
+  schema_name = googleworkspace_schema.employee.schema_name
+
+  schema_values = {
+    "employee-number" = jsonencode(1234)
+    "cost-center"     = jsonencode("Sales")
+  }
+}
@@ -0,0 +1,5 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+# The ID is the user's primary email address, alias email address, or unique user ID
+terraform import googleworkspace_user_photo.dwight 123456789012345678901
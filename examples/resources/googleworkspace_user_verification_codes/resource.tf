@@ -0,0 +1,11 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "googleworkspace_user_verification_codes" "dwight" {
+  user_id = "dwight.schrute@example.com"
+
+  # generate new codes by changing this value
+  triggers = {
+    rotation = "2024-01"
+  }
+}
@@ -0,0 +1,33 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+# users.csv:
+#
+# primary_email,given_name,family_name,org_unit_path,employee_id,department,title
+# jim.halpert@example.com,Jim,Halpert,/sales,100,Sales,Salesman
+# dwight.schrute@example.com,Dwight,Schrute,/sales,101,Sales,Assistant to the Regional Manager
+resource "googleworkspace_users_bulk" "hr_export" {
+  source_file = "${path.module}/users.csv"
+  key_field   = "employee_id"
+
+  removal_action     = "SUSPEND"
+  suspend_on_destroy = true
+  concurrency        = 25
+}
+
+resource "googleworkspace_users_bulk" "interns" {
+  users {
+    primary_email = "ryan.howard@example.com"
+    given_name    = "Ryan"
+    family_name   = "Howard"
+    org_unit_path = "/interns"
+  }
+
+  users {
+    primary_email = "erin.hannon@example.com"
+    given_name    = "Erin"
+    family_name   = "Hannon"
+    org_unit_path = "/interns"
+    title         = "Receptionist"
+  }
+}
@@ -0,0 +1,239 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"google.golang.org/api/chromepolicy/v1"
+)
+
+func dataSourceChromePoliciesResolved() *schema.Resource {
+	policyTargetKeySchema := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"target_resource": {
+				Description: "The target resource, either `orgunits/{orgunit_id}` or `groups/{group_id}`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"additional_target_keys": {
+				Description: "Map containing the additional target key name and value pairs used to further identify the target of the policy.",
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		Description: "Resolved Chrome Policies data source in the Terraform Googleworkspace provider. Returns the " +
+			"effective policy values for an org unit or group, including values inherited from parent org units, " +
+			"along with the source each value was obtained from. Chrome Policies reside under the " +
+			"`https://www.googleapis.com/auth/chrome.management.policy` client scope.",
+
+		ReadContext: dataSourceChromePoliciesResolvedRead,
+
+		Schema: map[string]*schema.Schema{
+			"policy_schema_filter": {
+				Description: "The schema filter to apply to the resolve request. Specify a schema name to view a " +
+					"particular schema, for example `chrome.users.ShowLogoutButton`. Wildcards are supported, but " +
+					"only in the leaf portion of the schema name, for example `chrome.users.*`.",
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"org_unit_id": {
+				Description:      "The target org unit to resolve policies for.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ExactlyOneOf:     []string{"org_unit_id", "group_id"},
+				DiffSuppressFunc: diffSuppressOrgUnitId,
+			},
+			"group_id": {
+				Description:  "The target group to resolve policies for.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"org_unit_id", "group_id"},
+			},
+			"additional_target_keys": {
+				Description: "Additional target key name and value pairs used to further identify the target of the " +
+					"policy, for example `app_id` or `printer_id`.",
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"resolved_policies": {
+				Description: "The resolved policies found by the resolve request.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"schema_name": {
+							Description: "The full qualified name of the policy schema.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"schema_values": {
+							Description: "JSON encoded map that represents the resolved key/value pairs of the policy.",
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"target_key": {
+							Description: "The target resource for which the resolved policy value applies.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        policyTargetKeySchema,
+						},
+						"source_key": {
+							Description: "The source resource from which this policy value is obtained. May be the " +
+								"same as `target_key` if the policy is directly modified on the target. If empty, " +
+								"the source is the default value for the customer.",
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     policyTargetKeySchema,
+						},
+						"added_source_key": {
+							Description: "The resource at which the entity was explicitly added for management, " +
+								"for policies (such as apps and networks) that only apply once added.",
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     policyTargetKeySchema,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceChromePoliciesResolvedRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	chromePolicyService, diags := client.NewChromePolicyService()
+	if diags.HasError() {
+		return diags
+	}
+
+	chromePoliciesService, diags := GetChromePoliciesService(chromePolicyService)
+	if diags.HasError() {
+		return diags
+	}
+
+	policyTargetKey := &chromepolicy.GoogleChromePolicyVersionsV1PolicyTargetKey{}
+	if v, ok := d.GetOk("org_unit_id"); ok {
+		policyTargetKey.TargetResource = "orgunits/" + strings.TrimPrefix(v.(string), "id:")
+	} else {
+		policyTargetKey.TargetResource = "groups/" + d.Get("group_id").(string)
+	}
+
+	additionalTargetKeys := map[string]string{}
+	for k, v := range d.Get("additional_target_keys").(map[string]interface{}) {
+		additionalTargetKeys[k] = v.(string)
+	}
+	if len(additionalTargetKeys) > 0 {
+		policyTargetKey.AdditionalTargetKeys = additionalTargetKeys
+	}
+
+	filter := d.Get("policy_schema_filter").(string)
+
+	log.Printf("[DEBUG] Resolving Chrome Policies for %s with filter %s", policyTargetKey.TargetResource, filter)
+
+	var result []*chromepolicy.GoogleChromePolicyVersionsV1ResolvedPolicy
+	err := chromePoliciesService.Resolve(fmt.Sprintf("customers/%s", client.Customer), &chromepolicy.GoogleChromePolicyVersionsV1ResolveRequest{
+		PolicySchemaFilter: filter,
+		PolicyTargetKey:    policyTargetKey,
+	}).Pages(ctx, func(resp *chromepolicy.GoogleChromePolicyVersionsV1ResolveResponse) error {
+		result = append(result, resp.ResolvedPolicies...)
+
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resolvedPolicies, err := flattenResolvedPolicies(result)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("resolved_policies", resolvedPolicies); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", policyTargetKey.TargetResource, filter))
+
+	log.Printf("[DEBUG] Finished resolving Chrome Policies for %s with filter %s", policyTargetKey.TargetResource, filter)
+	return nil
+}
+
+func flattenResolvedPolicies(rps []*chromepolicy.GoogleChromePolicyVersionsV1ResolvedPolicy) ([]interface{}, error) {
+	result := make([]interface{}, 0, len(rps))
+
+	for _, rp := range rps {
+		obj := map[string]interface{}{
+			"target_key":       flattenPolicyTargetKey(rp.TargetKey),
+			"source_key":       flattenPolicyTargetKey(rp.SourceKey),
+			"added_source_key": flattenPolicyTargetKey(rp.AddedSourceKey),
+		}
+
+		if rp.Value != nil {
+			obj["schema_name"] = rp.Value.PolicySchema
+
+			var schemaValuesObj map[string]interface{}
+			if err := json.Unmarshal(rp.Value.Value, &schemaValuesObj); err != nil {
+				return nil, err
+			}
+
+			schemaValues := map[string]interface{}{}
+			for k, v := range schemaValuesObj {
+				jsonVal, err := json.Marshal(v)
+				if err != nil {
+					return nil, err
+				}
+				schemaValues[k] = string(jsonVal)
+			}
+			obj["schema_values"] = schemaValues
+		}
+
+		result = append(result, obj)
+	}
+
+	// the API does not guarantee ordering, sort by schema name for a stable result
+	sort.SliceStable(result, func(i, j int) bool {
+		return fmt.Sprint(result[i].(map[string]interface{})["schema_name"]) < fmt.Sprint(result[j].(map[string]interface{})["schema_name"])
+	})
+
+	return result, nil
+}
+
+func flattenPolicyTargetKey(key *chromepolicy.GoogleChromePolicyVersionsV1PolicyTargetKey) []interface{} {
+	if key == nil {
+		return nil
+	}
+
+	additionalTargetKeys := map[string]interface{}{}
+	for k, v := range key.AdditionalTargetKeys {
+		additionalTargetKeys[k] = v
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"target_resource":        key.TargetResource,
+			"additional_target_keys": additionalTargetKeys,
+		},
+	}
+}
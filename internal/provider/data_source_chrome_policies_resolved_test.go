@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceChromePoliciesResolved(t *testing.T) {
+	t.Parallel()
+
+	ouName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceChromePoliciesResolved(ouName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.googleworkspace_chrome_policies_resolved.test", "resolved_policies.#", "1"),
+					resource.TestCheckResourceAttr("data.googleworkspace_chrome_policies_resolved.test", "resolved_policies.0.schema_name", "chrome.users.MaxConnectionsPerProxy"),
+					resource.TestCheckResourceAttr("data.googleworkspace_chrome_policies_resolved.test", "resolved_policies.0.schema_values.%", "1"),
+					resource.TestCheckResourceAttrPair("data.googleworkspace_chrome_policies_resolved.test", "resolved_policies.0.source_key.0.target_resource",
+						"data.googleworkspace_chrome_policies_resolved.test", "resolved_policies.0.target_key.0.target_resource"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceChromePoliciesResolved(ouName string) string {
+	return fmt.Sprintf(`
+resource "googleworkspace_org_unit" "test" {
+  name = "%s"
+  parent_org_unit_path = "/"
+}
+
+resource "googleworkspace_chrome_policy" "test" {
+  org_unit_id = googleworkspace_org_unit.test.id
+  policies {
+    schema_name = "chrome.users.MaxConnectionsPerProxy"
+    schema_values = {
+      maxConnectionsPerProxy = jsonencode(34)
+    }
+  }
+}
+
+data "googleworkspace_chrome_policies_resolved" "test" {
+  org_unit_id          = googleworkspace_chrome_policy.test.org_unit_id
+  policy_schema_filter = "chrome.users.MaxConnectionsPerProxy"
+}
+`, ouName)
+}
@@ -0,0 +1,135 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"google.golang.org/api/chromepolicy/v1"
+)
+
+func dataSourceChromePolicySchemas() *schema.Resource {
+	// Generate the list element schema from the single schema datasource
+	dsPolicySchemaSchema := datasourceSchemaFromResourceSchema(dataSourceChromePolicySchema().Schema)
+
+	// the proto definition is large and not useful when browsing the catalog,
+	// use googleworkspace_chrome_policy_schema to retrieve it for a single schema
+	removeFieldsFromSchema(dsPolicySchemaSchema, "definition")
+
+	dsPolicySchemaSchema["category_title"] = &schema.Schema{
+		Description: "Title of the category in which a setting belongs.",
+		Type:        schema.TypeString,
+		Computed:    true,
+	}
+	dsPolicySchemaSchema["supported_platforms"] = &schema.Schema{
+		Description: "List indicates that the policy will only apply to devices/users on these platforms.",
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+	dsPolicySchemaSchema["valid_target_resources"] = &schema.Schema{
+		Description: "Information about applicable target resources for the policy, e.g. `ORG_UNIT` or `GROUP`.",
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+
+	return &schema.Resource{
+		Description: "Chrome Policy Schemas data source in the Terraform Googleworkspace provider. Chrome Policy Schema " +
+			"resides under the `https://www.googleapis.com/auth/chrome.management.policy` client scope.",
+
+		ReadContext: dataSourceChromePolicySchemasRead,
+
+		Schema: map[string]*schema.Schema{
+			"filter": {
+				Description: "The schema filter used to find a particular schema based on fields like its resource " +
+					"name, description and `additionalTargetKeyNames`, for example `category:Printing` or " +
+					"`name:chrome.users.apps`. See the " +
+					"[API documentation](https://developers.google.com/chrome/policy/reference/rest/v1/customers.policySchemas/list) " +
+					"for the supported syntax.",
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"schemas": {
+				Description: "A list of Chrome Policy Schemas matching the filter.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: dsPolicySchemaSchema,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceChromePolicySchemasRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	chromePolicyService, diags := client.NewChromePolicyService()
+	if diags.HasError() {
+		return diags
+	}
+
+	chromePolicySchemasService, diags := GetChromePolicySchemasService(chromePolicyService)
+	if diags.HasError() {
+		return diags
+	}
+
+	filter := d.Get("filter").(string)
+
+	listCall := chromePolicySchemasService.List(fmt.Sprintf("customers/%s", client.Customer))
+	if filter != "" {
+		listCall = listCall.Filter(filter)
+	}
+
+	var result []*chromepolicy.GoogleChromePolicyVersionsV1PolicySchema
+	err := listCall.Pages(ctx, func(resp *chromepolicy.GoogleChromePolicyVersionsV1ListPolicySchemasResponse) error {
+		result = append(result, resp.PolicySchemas...)
+
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("schemas", flattenChromePolicySchemas(result)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("policySchemas/%s", filter))
+
+	return nil
+}
+
+func flattenChromePolicySchemas(policySchemas []*chromepolicy.GoogleChromePolicyVersionsV1PolicySchema) []interface{} {
+	result := make([]interface{}, len(policySchemas))
+
+	for i, policySchema := range policySchemas {
+		// this attribute contains recursive types, so we store it as json
+		fieldDescriptions, _ := json.MarshalIndent(policySchema.FieldDescriptions, "", "  ")
+
+		result[i] = map[string]interface{}{
+			"schema_name":                 policySchema.SchemaName,
+			"policy_description":          policySchema.PolicyDescription,
+			"category_title":              policySchema.CategoryTitle,
+			"support_uri":                 policySchema.SupportUri,
+			"additional_target_key_names": flattenAdditionalTargetKeyNames(policySchema.AdditionalTargetKeyNames),
+			"field_descriptions":          string(fieldDescriptions),
+			"access_restrictions":         policySchema.AccessRestrictions,
+			"notices":                     flattenNotices(policySchema.Notices),
+			"supported_platforms":         policySchema.SupportedPlatforms,
+			"valid_target_resources":      policySchema.ValidTargetResources,
+		}
+	}
+
+	return result
+}
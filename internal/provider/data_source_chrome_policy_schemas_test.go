@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceChromePolicySchemas(t *testing.T) {
+	t.Parallel()
+
+	filter := "name:chrome.printers.AllowForUsers"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceChromePolicySchemas(filter),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.googleworkspace_chrome_policy_schemas.test", "schemas.#", "1"),
+					resource.TestCheckResourceAttr("data.googleworkspace_chrome_policy_schemas.test", "schemas.0.schema_name", "chrome.printers.AllowForUsers"),
+					resource.TestCheckResourceAttr("data.googleworkspace_chrome_policy_schemas.test", "schemas.0.policy_description", "Allows a printer for users in a given organization."),
+					resource.TestCheckResourceAttr("data.googleworkspace_chrome_policy_schemas.test", "schemas.0.additional_target_key_names.0.key", "printer_id"),
+					resource.TestCheckResourceAttrSet("data.googleworkspace_chrome_policy_schemas.test", "schemas.0.field_descriptions"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceChromePolicySchemas(filter string) string {
+	return fmt.Sprintf(`
+data "googleworkspace_chrome_policy_schemas" "test" {
+  filter = "%s"
+}
+`, filter)
+}
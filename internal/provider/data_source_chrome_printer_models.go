@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	directory "google.golang.org/api/admin/directory/v1"
+)
+
+func dataSourceChromePrinterModels() *schema.Resource {
+	return &schema.Resource{
+		Description: "Chrome Printer Models data source in the Terraform Googleworkspace provider. Lists the printer " +
+			"models that are currently allowed to be configured for ChromeOS. Chrome Printer Models reside under the " +
+			"`https://www.googleapis.com/auth/admin.chrome.printers` client scope.",
+
+		ReadContext: dataSourceChromePrinterModelsRead,
+
+		Schema: map[string]*schema.Schema{
+			"filter": {
+				Description: "Filter to list only models by a specific manufacturer, e.g. `manufacturer:Brother`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"printer_models": {
+				Description: "A list of printer models.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"display_name": {
+							Description: "Display name, e.g. `Brother ModelName`.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"make_and_model": {
+							Description: "Make and model as represented in the `make_and_model` field of " +
+								"`googleworkspace_chrome_printer`.",
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"manufacturer": {
+							Description: "Manufacturer, e.g. `Brother`.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceChromePrinterModelsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return diags
+	}
+
+	printersService, diags := GetChromePrintersService(directoryService)
+	if diags.HasError() {
+		return diags
+	}
+
+	filter := d.Get("filter").(string)
+
+	listCall := printersService.ListPrinterModels(fmt.Sprintf("customers/%s", client.Customer))
+	if filter != "" {
+		listCall = listCall.Filter(filter)
+	}
+
+	var result []interface{}
+	err := listCall.Pages(ctx, func(resp *directory.ListPrinterModelsResponse) error {
+		for _, model := range resp.PrinterModels {
+			result = append(result, map[string]interface{}{
+				"display_name":   model.DisplayName,
+				"make_and_model": model.MakeAndModel,
+				"manufacturer":   model.Manufacturer,
+			})
+		}
+
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("printer_models", result); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("printerModels/%s", filter))
+
+	return diags
+}
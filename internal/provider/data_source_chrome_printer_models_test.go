@@ -0,0 +1,36 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceChromePrinterModels(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceChromePrinterModels(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.googleworkspace_chrome_printer_models.test", "printer_models.0.make_and_model"),
+					resource.TestCheckResourceAttr("data.googleworkspace_chrome_printer_models.test", "printer_models.0.manufacturer", "Brother"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceChromePrinterModels() string {
+	return `
+data "googleworkspace_chrome_printer_models" "test" {
+  filter = "manufacturer:Brother"
+}
+`
+}
@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceDataTransferApplications() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data Transfer Applications data source in the Terraform Googleworkspace provider. Lists the " +
+			"applications that support data transfers, along with their transfer parameters. Data Transfer " +
+			"Applications resides under the `https://www.googleapis.com/auth/admin.datatransfer` client scope.",
+
+		ReadContext: dataSourceDataTransferApplicationsRead,
+
+		Schema: map[string]*schema.Schema{
+			"applications": {
+				Description: "A list of the applications that support data transfers.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The ID of the application.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "The name of the application, e.g. `Drive and Docs`.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"transfer_params": {
+							Description: "The transfer parameters supported by the application, with their possible values.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key": {
+										Description: "The type of the transfer parameter.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"value": {
+										Description: "The possible values of the transfer parameter.",
+										Type:        schema.TypeList,
+										Computed:    true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDataTransferApplicationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	dataTransferService, diags := client.NewDataTransferService()
+	if diags.HasError() {
+		return diags
+	}
+
+	applicationsService, diags := GetTransferApplicationsService(dataTransferService)
+	if diags.HasError() {
+		return diags
+	}
+
+	transferApplications, err := listTransferApplications(ctx, applicationsService)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var applications []interface{}
+	for _, application := range transferApplications {
+		applications = append(applications, map[string]interface{}{
+			"id":              strconv.FormatInt(application.Id, 10),
+			"name":            application.Name,
+			"transfer_params": flattenApplicationTransferParams(application.TransferParams),
+		})
+	}
+
+	if err := d.Set("applications", applications); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(client.Customer)
+
+	return diags
+}
@@ -0,0 +1,34 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDataTransferApplications(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceDataTransferApplications(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.googleworkspace_data_transfer_applications.test", "applications.0.id"),
+					resource.TestCheckResourceAttrSet("data.googleworkspace_data_transfer_applications.test", "applications.0.name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceDataTransferApplications() string {
+	return `
+data "googleworkspace_data_transfer_applications" "test" {}
+`
+}
@@ -13,6 +13,7 @@ import (
 func dataSourceGroup() *schema.Resource {
 	// Generate datasource schema from resource
 	dsSchema := datasourceSchemaFromResourceSchema(resourceGroup().Schema)
+	removeFieldsFromSchema(dsSchema, "adopt_existing", "keep_old_email_as_alias", "previous_email_aliases")
 	addExactlyOneOfFieldsToSchema(dsSchema, "id", "email")
 
 	return &schema.Resource{
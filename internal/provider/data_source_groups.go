@@ -14,6 +14,7 @@ import (
 func dataSourceGroups() *schema.Resource {
 	// Generate datasource schema from resource
 	dsGroupSchema := datasourceSchemaFromResourceSchema(resourceGroup().Schema)
+	removeFieldsFromSchema(dsGroupSchema, "adopt_existing", "keep_old_email_as_alias", "previous_email_aliases")
 
 	return &schema.Resource{
 		// This description is used by the documentation generator and the language server.
@@ -14,6 +14,7 @@ import (
 func dataSourceOrgUnit() *schema.Resource {
 	// Generate datasource schema from resource
 	dsSchema := datasourceSchemaFromResourceSchema(resourceOrgUnit().Schema)
+	removeFieldsFromSchema(dsSchema, "adopt_existing")
 	addExactlyOneOfFieldsToSchema(dsSchema, "org_unit_id", "org_unit_path")
 
 	return &schema.Resource{
@@ -5,12 +5,9 @@ package googleworkspace
 
 import (
 	"context"
-	"errors"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-
-	directory "google.golang.org/api/admin/directory/v1"
 )
 
 func dataSourceRole() *schema.Resource {
@@ -41,23 +38,11 @@ func dataSourceRoleRead(ctx context.Context, d *schema.ResourceData, meta interf
 	}
 
 	name := d.Get("name").(string)
-	var role *directory.Role
-	if err := rolesService.List(client.Customer).Pages(ctx, func(roles *directory.Roles) error {
-		for _, r := range roles.Items {
-			if r.RoleName == name {
-				role = r
-				return errors.New("role was found") // return error to stop pagination
-			}
-		}
-		return nil
-	}); role == nil && err != nil {
+	role, err := findRoleByName(ctx, rolesService, client.Customer, name)
+	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	if role == nil {
-		return diag.Errorf("No role with name %q", name)
-	}
-
 	if diags := setRole(d, role); diags.HasError() {
 		return diags
 	}
@@ -14,7 +14,7 @@ func dataSourceUser() *schema.Resource {
 	// Generate datasource schema from resource
 	dsSchema := datasourceSchemaFromResourceSchema(resourceUser().Schema)
 	addExactlyOneOfFieldsToSchema(dsSchema, "id", "primary_email")
-	removeFieldsFromSchema(dsSchema, "on_delete_data_transfer")
+	removeFieldsFromSchema(dsSchema, "on_delete_data_transfer", "password_wo", "password_version", "generated_password", "deletion_policy", "undelete_on_create", "adopt_existing", "sign_out_on_suspend", "update_mode", "last_sign_out_time", "last_revoked_client_ids", "keep_old_email_as_alias", "previous_email_aliases")
 
 	return &schema.Resource{
 		// This description is used by the documentation generator and the language server.
@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	directory "google.golang.org/api/admin/directory/v1"
+)
+
+func dataSourceUserAsps() *schema.Resource {
+	return &schema.Resource{
+		Description: "User ASPs data source in the Terraform Googleworkspace provider. Lists the application-specific " +
+			"passwords (ASPs) issued by a user. User ASPs resides under the " +
+			"`https://www.googleapis.com/auth/admin.directory.user.security` client scope.",
+
+		ReadContext: dataSourceUserAspsRead,
+
+		Schema: map[string]*schema.Schema{
+			"user_id": {
+				Description: "The user's primary email address, alias email address, or unique user ID.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"asps": {
+				Description: "A list of the application-specific passwords of the user.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"code_id": {
+							Description: "The unique ID of the ASP.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"name": {
+							Description: "The name of the application that the user, represented by their userId, entered when the ASP was created.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"creation_time": {
+							Description: "The time when the ASP was created, in RFC 3339 format.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"last_time_used": {
+							Description: "The time when the ASP was last used, in RFC 3339 format. Empty if it was never used.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceUserAspsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return diags
+	}
+
+	aspsService, diags := GetAspsService(directoryService)
+	if diags.HasError() {
+		return diags
+	}
+
+	userId := d.Get("user_id").(string)
+
+	asps, err := aspsService.List(userId).Do()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("asps", flattenAsps(asps.Items)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(userId)
+
+	return diags
+}
+
+func flattenAsps(asps []*directory.Asp) []interface{} {
+	result := make([]interface{}, len(asps))
+
+	for i, asp := range asps {
+		result[i] = map[string]interface{}{
+			"code_id":        asp.CodeId,
+			"name":           asp.Name,
+			"creation_time":  flattenEpochMillis(asp.CreationTime),
+			"last_time_used": flattenEpochMillis(asp.LastTimeUsed),
+		}
+	}
+
+	return result
+}
+
+// flattenEpochMillis formats a timestamp in milliseconds as RFC 3339, zero values are returned as empty strings
+func flattenEpochMillis(millis int64) string {
+	if millis == 0 {
+		return ""
+	}
+
+	return time.UnixMilli(millis).UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	directory "google.golang.org/api/admin/directory/v1"
+)
+
+func dataSourceUserTokens() *schema.Resource {
+	return &schema.Resource{
+		Description: "User Tokens data source in the Terraform Googleworkspace provider. Lists the OAuth access " +
+			"tokens a user has granted to third-party applications. User Tokens resides under the " +
+			"`https://www.googleapis.com/auth/admin.directory.user.security` client scope.",
+
+		ReadContext: dataSourceUserTokensRead,
+
+		Schema: map[string]*schema.Schema{
+			"user_id": {
+				Description: "The user's primary email address, alias email address, or unique user ID.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"tokens": {
+				Description: "A list of the OAuth tokens granted by the user.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"client_id": {
+							Description: "The Client ID of the application the token is issued to.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"display_text": {
+							Description: "The displayable name of the application the token is issued to.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"scopes": {
+							Description: "A list of authorization scopes the application is granted.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"anonymous": {
+							Description: "Whether the application is registered with Google.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"native_app": {
+							Description: "Whether the token is issued to an installed application.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceUserTokensRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return diags
+	}
+
+	tokensService, diags := GetTokensService(directoryService)
+	if diags.HasError() {
+		return diags
+	}
+
+	userId := d.Get("user_id").(string)
+
+	tokens, err := tokensService.List(userId).Do()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("tokens", flattenTokens(tokens.Items)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(userId)
+
+	return diags
+}
+
+func flattenTokens(tokens []*directory.Token) []interface{} {
+	result := make([]interface{}, len(tokens))
+
+	for i, token := range tokens {
+		result[i] = map[string]interface{}{
+			"client_id":    token.ClientId,
+			"display_text": token.DisplayText,
+			"scopes":       token.Scopes,
+			"anonymous":    token.Anonymous,
+			"native_app":   token.NativeApp,
+		}
+	}
+
+	return result
+}
@@ -5,6 +5,7 @@ package googleworkspace
 
 import (
 	"context"
+	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	directory "google.golang.org/api/admin/directory/v1"
@@ -13,7 +14,7 @@ import (
 func dataSourceUsers() *schema.Resource {
 	// Generate datasource schema from resource
 	dsUserSchema := datasourceSchemaFromResourceSchema(resourceUser().Schema)
-	removeFieldsFromSchema(dsUserSchema, "on_delete_data_transfer")
+	removeFieldsFromSchema(dsUserSchema, "on_delete_data_transfer", "password_wo", "password_version", "generated_password", "deletion_policy", "undelete_on_create", "adopt_existing", "sign_out_on_suspend", "update_mode", "last_sign_out_time", "last_revoked_client_ids", "keep_old_email_as_alias", "previous_email_aliases")
 
 	return &schema.Resource{
 		// This description is used by the documentation generator and the language server.
@@ -61,7 +62,12 @@ func dataSourceUsersRead(ctx context.Context, d *schema.ResourceData, meta inter
 		return handleNotFoundError(err, d, "users")
 	}
 
-	if err := d.Set("users", flattenUsers(result, client)); err != nil {
+	users, err := flattenUsers(result, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("users", users); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -70,27 +76,36 @@ func dataSourceUsersRead(ctx context.Context, d *schema.ResourceData, meta inter
 	return diags
 }
 
-func flattenUsers(users []*directory.User, client *apiClient) interface{} {
+func flattenUsers(users []*directory.User, client *apiClient) ([]interface{}, error) {
 	var result []interface{}
 
 	for _, user := range users {
-		result = append(result, flattenUser(user, client))
+		flattened, err := flattenUser(user, client)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, flattened)
 	}
 
-	return result
+	return result, nil
 }
 
-func flattenUser(user *directory.User, client *apiClient) interface{} {
-	var diags diag.Diagnostics
-
+func flattenUser(user *directory.User, client *apiClient) (map[string]interface{}, error) {
 	customSchemas := []map[string]interface{}{}
 	if len(user.CustomSchemas) > 0 {
+		var diags diag.Diagnostics
 		customSchemas, diags = flattenCustomSchemas(user.CustomSchemas, client)
 		if diags.HasError() {
-			return diags
+			return nil, fmt.Errorf("error flattening custom schemas of user %s: %s", user.PrimaryEmail, diags[0].Summary)
 		}
 	}
 
+	nested, err := decodeUserNestedLists(user)
+	if err != nil {
+		return nil, err
+	}
+
 	result := map[string]interface{}{}
 	result["primary_email"] = user.PrimaryEmail
 	result["is_admin"] = user.IsAdmin
@@ -100,30 +115,30 @@ func flattenUser(user *directory.User, client *apiClient) interface{} {
 	result["change_password_at_next_login"] = user.ChangePasswordAtNextLogin
 	result["ip_allowlist"] = user.IpWhitelisted
 	result["name"] = flattenName(user.Name)
-	result["emails"] = flattenInterfaceObjects(user.Emails)
-	result["external_ids"] = flattenInterfaceObjects(user.ExternalIds)
-	result["relations"] = flattenInterfaceObjects(user.Relations)
+	result["emails"] = flattenUserEmails(nested.Emails, true)
+	result["external_ids"] = flattenUserExternalIds(nested.ExternalIds)
+	result["relations"] = flattenUserRelations(nested.Relations)
 	result["aliases"] = user.Aliases
 	result["is_mailbox_setup"] = user.IsMailboxSetup
 	result["customer_id"] = user.CustomerId
-	result["addresses"] = flattenInterfaceObjects(user.Addresses)
-	result["organizations"] = flattenInterfaceObjects(user.Organizations)
+	result["addresses"] = flattenUserAddresses(nested.Addresses)
+	result["organizations"] = flattenUserOrganizations(nested.Organizations)
 	result["last_login_time"] = user.LastLoginTime
-	result["phones"] = flattenInterfaceObjects(user.Phones)
+	result["phones"] = flattenUserPhones(nested.Phones)
 	result["suspension_reason"] = user.SuspensionReason
 	result["thumbnail_photo_url"] = user.ThumbnailPhotoUrl
-	result["languages"] = flattenInterfaceObjects(user.Languages)
-	result["posix_accounts"] = flattenInterfaceObjects(user.PosixAccounts)
+	result["languages"] = flattenUserLanguages(nested.Languages)
+	result["posix_accounts"] = flattenUserPosixAccounts(nested.PosixAccounts)
 	result["creation_time"] = user.CreationTime
 	result["non_editable_aliases"] = user.NonEditableAliases
-	result["ssh_public_keys"] = flattenInterfaceObjects(user.SshPublicKeys)
-	result["websites"] = flattenInterfaceObjects(user.Websites)
-	result["locations"] = flattenInterfaceObjects(user.Locations)
+	result["ssh_public_keys"] = flattenUserSshPublicKeys(nested.SshPublicKeys)
+	result["websites"] = flattenUserWebsites(nested.Websites)
+	result["locations"] = flattenUserLocations(nested.Locations)
 	result["include_in_global_address_list"] = user.IncludeInGlobalAddressList
-	result["keywords"] = flattenInterfaceObjects(user.Keywords)
+	result["keywords"] = flattenUserKeywords(nested.Keywords)
 	result["deletion_time"] = user.DeletionTime
 	result["thumbnail_photo_etag"] = user.ThumbnailPhotoEtag
-	result["ims"] = flattenInterfaceObjects(user.Ims)
+	result["ims"] = flattenUserIms(nested.Ims)
 	result["custom_schemas"] = customSchemas
 	result["is_enrolled_in_2_step_verification"] = user.IsEnrolledIn2Sv
 	result["is_enforced_in_2_step_verification"] = user.IsEnforcedIn2Sv
@@ -132,5 +147,5 @@ func flattenUser(user *directory.User, client *apiClient) interface{} {
 	result["recovery_email"] = user.RecoveryEmail
 	result["recovery_phone"] = user.RecoveryPhone
 
-	return result
+	return result, nil
 }
@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+)
+
+// emailRename changes the primary email of a user or a group. Google keeps the old address as an
+// alias of the renamed resource, which is then either kept or deleted.
+type emailRename struct {
+	resourceType string
+	id           string
+	oldEmail     string
+	newEmail     string
+
+	// rename sends the new primary email to the API
+	rename func() error
+	// resolve returns the ID and the primary email of the resource the email (or alias) belongs to
+	resolve func(email string) (string, string, error)
+	// deleteAlias deletes an alias of the resource
+	deleteAlias func(alias string) error
+}
+
+// apply renames the resource and waits until both addresses resolve to it. The old address is deleted,
+// unless keepOldEmail is set or the old address is one of the configured aliases. It returns whether
+// the old address was kept as an alias that is not configured.
+func (r *emailRename) apply(ctx context.Context, timeout time.Duration, keepOldEmail bool, configuredAliases []string) (bool, error) {
+	log.Printf("[DEBUG] Renaming %s %q from %s to %s", r.resourceType, r.id, r.oldEmail, r.newEmail)
+
+	if err := r.rename(); err != nil {
+		return false, err
+	}
+
+	// the rename is eventually consistent, the new address must resolve to the resource,
+	// and the old address must have become one of its aliases
+	err := retryTimeDuration(ctx, timeout, func() error {
+		id, primaryEmail, retryErr := r.resolve(r.newEmail)
+		if retryErr != nil && !isNotFound(retryErr) {
+			return fmt.Errorf("unexpected error during retries of %s rename: %s", r.resourceType, retryErr)
+		}
+
+		if retryErr != nil || id != r.id || !strings.EqualFold(primaryEmail, r.newEmail) {
+			return fmt.Errorf("timed out while waiting for %s to be renamed to %s", r.resourceType, r.newEmail)
+		}
+
+		id, _, retryErr = r.resolve(r.oldEmail)
+		if retryErr != nil && !isNotFound(retryErr) {
+			return fmt.Errorf("unexpected error during retries of %s rename: %s", r.resourceType, retryErr)
+		}
+
+		if retryErr != nil || id != r.id {
+			return fmt.Errorf("timed out while waiting for %s to be an alias of the renamed %s", r.oldEmail, r.resourceType)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	configured := false
+	for _, alias := range configuredAliases {
+		if strings.EqualFold(alias, r.oldEmail) {
+			configured = true
+			break
+		}
+	}
+
+	kept := false
+	switch {
+	case configured:
+		log.Printf("[DEBUG] Keeping %s as a configured alias of %s %q", r.oldEmail, r.resourceType, r.id)
+	case keepOldEmail:
+		log.Printf("[DEBUG] Keeping %s as an alias of %s %q", r.oldEmail, r.resourceType, r.id)
+		kept = true
+	default:
+		if err := r.deleteOldEmail(ctx, timeout); err != nil {
+			return false, err
+		}
+	}
+
+	log.Printf("[DEBUG] Finished renaming %s %q from %s to %s", r.resourceType, r.id, r.oldEmail, r.newEmail)
+
+	return kept, nil
+}
+
+// deleteOldEmail deletes the old address, which Google kept as an alias of the renamed resource
+func (r *emailRename) deleteOldEmail(ctx context.Context, timeout time.Duration) error {
+	if err := r.deleteAlias(r.oldEmail); err != nil && !isNotFound(err) {
+		return fmt.Errorf("error deleting old email %s of %s %q: %s", r.oldEmail, r.resourceType, r.id, err)
+	}
+
+	// wait until the old address no longer resolves, so it doesn't show up in the aliases read afterwards
+	return retryTimeDuration(ctx, timeout, func() error {
+		_, _, retryErr := r.resolve(r.oldEmail)
+		if isNotFound(retryErr) {
+			return nil
+		}
+
+		if retryErr != nil {
+			return fmt.Errorf("unexpected error during retries of %s rename: %s", r.resourceType, retryErr)
+		}
+
+		return fmt.Errorf("timed out while waiting for old email %s to be deleted", r.oldEmail)
+	})
+}
+
+// withoutPreviousEmails returns the aliases that are not previous emails kept as aliases, and the
+// previous emails that are still aliases
+func withoutPreviousEmails(aliases []string, previousEmails []string) ([]string, []string) {
+	result := []string{}
+	kept := []string{}
+
+	for _, alias := range aliases {
+		previous := false
+		for _, email := range previousEmails {
+			if strings.EqualFold(alias, email) {
+				previous = true
+				break
+			}
+		}
+
+		if previous {
+			kept = append(kept, alias)
+		} else {
+			result = append(result, alias)
+		}
+	}
+
+	return result, kept
+}
@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"bytes"
+	"context"
+	"log"
+	"net/http"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/api/googleapi"
+)
+
+// testEmailRename renames a resource in a fake directory, where the old email becomes an alias
+func testEmailRename(addresses map[string]string) (*emailRename, *[]string) {
+	deleted := []string{}
+	primary := "jim@example.com"
+
+	rename := &emailRename{
+		resourceType: "user",
+		id:           "123",
+		oldEmail:     "jim@example.com",
+		newEmail:     "jim.halpert@example.com",
+		rename: func() error {
+			addresses["jim.halpert@example.com"] = "123"
+			primary = "jim.halpert@example.com"
+			return nil
+		},
+		resolve: func(email string) (string, string, error) {
+			id, ok := addresses[strings.ToLower(email)]
+			if !ok {
+				return "", "", &googleapi.Error{Code: http.StatusNotFound}
+			}
+			return id, primary, nil
+		},
+		deleteAlias: func(alias string) error {
+			deleted = append(deleted, alias)
+			delete(addresses, strings.ToLower(alias))
+			return nil
+		},
+	}
+
+	return rename, &deleted
+}
+
+func TestEmailRename_apply(t *testing.T) {
+	cases := map[string]struct {
+		keepOldEmail      bool
+		configuredAliases []string
+		expectedKept      bool
+		expectedDeleted   []string
+	}{
+		"delete old email":     {false, nil, false, []string{"jim@example.com"}},
+		"keep old email":       {true, nil, true, []string{}},
+		"configured old email": {false, []string{"JIM@example.com"}, false, []string{}},
+	}
+
+	var logs bytes.Buffer
+	log.SetOutput(&logs)
+	defer log.SetOutput(os.Stderr)
+
+	for name, tc := range cases {
+		addresses := map[string]string{"jim@example.com": "123"}
+		rename, deleted := testEmailRename(addresses)
+
+		logs.Reset()
+		kept, err := rename.apply(context.Background(), time.Minute, tc.keepOldEmail, tc.configuredAliases)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+
+		if !strings.Contains(logs.String(), "Finished renaming user") {
+			t.Errorf("%s: expected the rename to be logged as finished, got: %s", name, logs.String())
+		}
+
+		if kept != tc.expectedKept {
+			t.Errorf("%s: expected kept to be %t, got %t", name, tc.expectedKept, kept)
+		}
+
+		if !reflect.DeepEqual(*deleted, tc.expectedDeleted) {
+			t.Errorf("%s: expected deleted aliases %v, got %v", name, tc.expectedDeleted, *deleted)
+		}
+	}
+}
+
+func TestEmailRename_withoutPreviousEmails(t *testing.T) {
+	aliases, kept := withoutPreviousEmails(
+		[]string{"support@example.com", "Jim@example.com"},
+		[]string{"jim@example.com", "jimothy@example.com"},
+	)
+
+	if !reflect.DeepEqual(aliases, []string{"support@example.com"}) {
+		t.Errorf("unexpected aliases: %v", aliases)
+	}
+
+	if !reflect.DeepEqual(kept, []string{"Jim@example.com"}) {
+		t.Errorf("unexpected previous emails: %v", kept)
+	}
+}
@@ -7,11 +7,13 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"path"
 	"strings"
 
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/mitchellh/go-homedir"
 
 	googleoauth "golang.org/x/oauth2/google"
@@ -107,6 +109,52 @@ func New(version string) func() *schema.Provider {
 					Elem:     &schema.Schema{Type: schema.TypeString},
 				},
 
+				"protected_users": {
+					Description: "Patterns of primary emails of users the provider refuses to delete or suspend, e.g. " +
+						"`*-admin@example.com`, including with the `deletion_policy` of `googleworkspace_user` and in " +
+						"`googleworkspace_users_bulk`. Patterns are matched case-insensitively, with the syntax of Go's " +
+						"`path.Match`. The user's current primary email is checked, rather than the one in the state.",
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:             schema.TypeString,
+						ValidateDiagFunc: validateProtectedUserPattern,
+					},
+				},
+
+				"protected_org_units": {
+					Description: "Full paths of org units whose users, including the users of child org units, the " +
+						"provider refuses to delete or suspend. The user's current org unit is checked, rather than the " +
+						"one in the state.",
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+
+				"protect_super_admins": {
+					Description: "If true, the provider refuses to delete or suspend users that currently have super " +
+						"administrator privileges.",
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"max_deletions_per_apply": {
+					Description: "The maximum number of users and groups the provider deletes in a single apply, to guard " +
+						"against planning the deletion of many users by mistake, e.g. with a bad `for_each`. Users suspended " +
+						"or archived by their `deletion_policy`, and users suspended by `googleworkspace_users_bulk`, are " +
+						"counted as deletions. Deletions are counted from the plan, so a plan with more deletions than " +
+						"the threshold fails with an error listing them, before anything is deleted. Terraform versions older " +
+						"than 1.3 don't plan destroys, so with them destroyed resources are only counted as they are deleted: " +
+						"the first `max_deletions_per_apply` deletions are applied, and the deletions beyond the threshold " +
+						"fail with an error listing the users and groups that were deleted and refused. This limits the " +
+						"damage rather than preventing it. `0` means no limit.",
+					Type:             schema.TypeInt,
+					Optional:         true,
+					Default:          0,
+					ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+				},
+
 				"service_account": {
 					Description: "The service account used to create the provided `access_token` if authenticating using " +
 						"the `access_token` method and needing to impersonate a user. This service account will require the " +
@@ -116,38 +164,58 @@ func New(version string) func() *schema.Provider {
 				},
 			},
 			DataSourcesMap: map[string]*schema.Resource{
-				"googleworkspace_chrome_policy_schema": dataSourceChromePolicySchema(),
-				"googleworkspace_domain":               dataSourceDomain(),
-				"googleworkspace_domain_alias":         dataSourceDomainAlias(),
-				"googleworkspace_group":                dataSourceGroup(),
-				"googleworkspace_groups":               dataSourceGroups(),
-				"googleworkspace_group_member":         dataSourceGroupMember(),
-				"googleworkspace_group_members":        dataSourceGroupMembers(),
-				"googleworkspace_group_settings":       dataSourceGroupSettings(),
-				"googleworkspace_org_unit":             dataSourceOrgUnit(),
-				"googleworkspace_privileges":           dataSourcePrivileges(),
-				"googleworkspace_role":                 dataSourceRole(),
-				"googleworkspace_schema":               dataSourceSchema(),
-				"googleworkspace_user":                 dataSourceUser(),
-				"googleworkspace_users":                dataSourceUsers(),
-				"googleworkspace_dynamic_group":        dataSourceDynamicGroup(),
+				"googleworkspace_chrome_policies_resolved":   dataSourceChromePoliciesResolved(),
+				"googleworkspace_chrome_policy_schema":       dataSourceChromePolicySchema(),
+				"googleworkspace_chrome_policy_schemas":      dataSourceChromePolicySchemas(),
+				"googleworkspace_chrome_printer_models":      dataSourceChromePrinterModels(),
+				"googleworkspace_data_transfer_applications": dataSourceDataTransferApplications(),
+				"googleworkspace_domain":                     dataSourceDomain(),
+				"googleworkspace_domain_alias":               dataSourceDomainAlias(),
+				"googleworkspace_group":                      dataSourceGroup(),
+				"googleworkspace_groups":                     dataSourceGroups(),
+				"googleworkspace_group_member":               dataSourceGroupMember(),
+				"googleworkspace_group_members":              dataSourceGroupMembers(),
+				"googleworkspace_group_settings":             dataSourceGroupSettings(),
+				"googleworkspace_org_unit":                   dataSourceOrgUnit(),
+				"googleworkspace_privileges":                 dataSourcePrivileges(),
+				"googleworkspace_role":                       dataSourceRole(),
+				"googleworkspace_schema":                     dataSourceSchema(),
+				"googleworkspace_user":                       dataSourceUser(),
+				"googleworkspace_user_asps":                  dataSourceUserAsps(),
+				"googleworkspace_user_tokens":                dataSourceUserTokens(),
+				"googleworkspace_users":                      dataSourceUsers(),
+				"googleworkspace_dynamic_group":              dataSourceDynamicGroup(),
 			},
 			ResourcesMap: map[string]*schema.Resource{
-				"googleworkspace_chrome_policy":       resourceChromePolicy(),
-				"googleworkspace_domain":              resourceDomain(),
-				"googleworkspace_domain_alias":        resourceDomainAlias(),
-				"googleworkspace_gmail_send_as_alias": resourceGmailSendAsAlias(),
-				"googleworkspace_group":               resourceGroup(),
-				"googleworkspace_group_member":        resourceGroupMember(),
-				"googleworkspace_group_members":       resourceGroupMembers(),
-				"googleworkspace_group_settings":      resourceGroupSettings(),
-				"googleworkspace_org_unit":            resourceOrgUnit(),
-				"googleworkspace_role":                resourceRole(),
-				"googleworkspace_role_assignment":     resourceRoleAssignment(),
-				"googleworkspace_schema":              resourceSchema(),
-				"googleworkspace_user":                resourceUser(),
-				"googleworkspace_dynamic_group":       resourceDynamicGroup(),
-				"googleworkspace_user_delegate":       resourceUserDelegate(),
+				"googleworkspace_chrome_app":                  resourceChromeApp(),
+				"googleworkspace_chrome_policy":               resourceChromePolicy(),
+				"googleworkspace_chrome_policy_file":          resourceChromePolicyFile(),
+				"googleworkspace_chrome_printer":              resourceChromePrinter(),
+				"googleworkspace_chrome_printers":             resourceChromePrinters(),
+				"googleworkspace_chrome_print_server":         resourceChromePrintServer(),
+				"googleworkspace_data_transfer":               resourceDataTransfer(),
+				"googleworkspace_domain":                      resourceDomain(),
+				"googleworkspace_domain_alias":                resourceDomainAlias(),
+				"googleworkspace_gmail_send_as_alias":         resourceGmailSendAsAlias(),
+				"googleworkspace_group":                       resourceGroup(),
+				"googleworkspace_group_member":                resourceGroupMember(),
+				"googleworkspace_group_members":               resourceGroupMembers(),
+				"googleworkspace_group_settings":              resourceGroupSettings(),
+				"googleworkspace_org_unit":                    resourceOrgUnit(),
+				"googleworkspace_org_unit_membership":         resourceOrgUnitMembership(),
+				"googleworkspace_role":                        resourceRole(),
+				"googleworkspace_role_assignment":             resourceRoleAssignment(),
+				"googleworkspace_schema":                      resourceSchema(),
+				"googleworkspace_super_admin":                 resourceSuperAdmin(),
+				"googleworkspace_user":                        resourceUser(),
+				"googleworkspace_user_alias":                  resourceUserAlias(),
+				"googleworkspace_user_credentials_revocation": resourceUserCredentialsRevocation(),
+				"googleworkspace_user_custom_attributes":      resourceUserCustomAttributes(),
+				"googleworkspace_user_photo":                  resourceUserPhoto(),
+				"googleworkspace_user_verification_codes":     resourceUserVerificationCodes(),
+				"googleworkspace_users_bulk":                  resourceUsersBulk(),
+				"googleworkspace_dynamic_group":               resourceDynamicGroup(),
+				"googleworkspace_user_delegate":               resourceUserDelegate(),
 			},
 		}
 
@@ -203,6 +271,21 @@ func configure(version string, p *schema.Provider) func(context.Context, *schema
 			config.ServiceAccount = v.(string)
 		}
 
+		// Get protection settings
+		config.userProtection = &userProtection{
+			protectSuperAdmins: d.Get("protect_super_admins").(bool),
+		}
+		for _, pattern := range d.Get("protected_users").([]interface{}) {
+			config.userProtection.protectedUsers = append(config.userProtection.protectedUsers, pattern.(string))
+		}
+		for _, ou := range d.Get("protected_org_units").([]interface{}) {
+			config.userProtection.protectedOrgUnits = append(config.userProtection.protectedOrgUnits, ou.(string))
+		}
+
+		config.deletionGuard = &deletionGuard{
+			maxDeletions: d.Get("max_deletions_per_apply").(int),
+		}
+
 		config.UserAgent = p.UserAgent("terraform-provider-googleworkspace", version)
 
 		// nolint
@@ -238,3 +321,17 @@ func validateCredentials(v interface{}, p cty.Path) diag.Diagnostics {
 
 	return diags
 }
+
+func validateProtectedUserPattern(v interface{}, p cty.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if _, err := path.Match(v.(string), ""); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       fmt.Sprintf("%q is not a valid pattern: %s", v.(string), err),
+			AttributePath: p,
+		})
+	}
+
+	return diags
+}
@@ -16,6 +16,7 @@ import (
 
 	datatransfer "google.golang.org/api/admin/datatransfer/v1"
 	directory "google.golang.org/api/admin/directory/v1"
+	"google.golang.org/api/chromemanagement/v1"
 	"google.golang.org/api/chromepolicy/v1"
 	"google.golang.org/api/cloudidentity/v1"
 	"google.golang.org/api/gmail/v1"
@@ -35,6 +36,9 @@ type apiClient struct {
 	ImpersonatedUserEmail string
 	ServiceAccount        string
 	UserAgent             string
+
+	userProtection *userProtection
+	deletionGuard  *deletionGuard
 }
 
 func (c *apiClient) loadAndValidate(ctx context.Context) diag.Diagnostics {
@@ -148,6 +152,28 @@ func (c *apiClient) SetupClient(ctx context.Context, creds *googleoauth.Credenti
 	return diags
 }
 
+func (c *apiClient) NewChromeManagementService() (*chromemanagement.Service, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	log.Printf("[INFO] Instantiating Google Admin Chrome Management service")
+
+	chromeManagementService, err := chromemanagement.NewService(context.Background(), option.WithHTTPClient(c.client))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	if chromeManagementService == nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Chrome Management Service could not be created.",
+		})
+
+		return nil, diags
+	}
+
+	return chromeManagementService, diags
+}
+
 func (c *apiClient) NewChromePolicyService() (*chromepolicy.Service, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
@@ -0,0 +1,154 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+
+	directory "google.golang.org/api/admin/directory/v1"
+)
+
+// deletionGuard counts the users and groups deleted, or suspended and archived on destroy, by the provider,
+// and refuses deletions beyond the configured threshold. Planned deletions are counted during plan, so a plan
+// with too many deletions fails before anything is deleted. Deletions are also counted as they happen during
+// apply, for the deletions that can't be planned.
+type deletionGuard struct {
+	mu sync.Mutex
+
+	maxDeletions int
+	planned      map[string]bool
+	deleted      []string
+	refused      []string
+}
+
+// planDeletions records the planned deletion of the given resources, or returns an error listing every
+// deletion planned so far when there are more than the threshold. A threshold of 0 means no limit.
+func (g *deletionGuard) planDeletions(resourceType string, names ...string) error {
+	if g == nil || g.maxDeletions <= 0 || len(names) == 0 {
+		return nil
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.planned == nil {
+		g.planned = map[string]bool{}
+	}
+
+	// resources can be planned more than once, e.g. when the plan is checked during apply
+	entries := []string{}
+	for _, name := range names {
+		entry := fmt.Sprintf("%s %s", resourceType, name)
+		entries = append(entries, entry)
+		g.planned[entry] = true
+	}
+
+	if len(g.planned) <= g.maxDeletions {
+		return nil
+	}
+
+	planned := []string{}
+	for entry := range g.planned {
+		planned = append(planned, entry)
+	}
+	sort.Strings(planned)
+
+	return fmt.Errorf("refusing to delete %s: %d users and groups would be deleted, which is more than the "+
+		"provider's max_deletions_per_apply of %d. Nothing has been deleted. Planned deletions: %s",
+		strings.Join(entries, ", "), len(planned), g.maxDeletions, strings.Join(planned, ", "))
+}
+
+// reserveDeletion records the deletion of the given resource, or returns an error listing
+// every deletion refused so far when the threshold has been reached. A threshold of 0 means no limit.
+func (g *deletionGuard) reserveDeletion(resourceType, name string) error {
+	if g == nil || g.maxDeletions <= 0 {
+		return nil
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	entry := fmt.Sprintf("%s %s", resourceType, name)
+	if len(g.deleted) < g.maxDeletions {
+		g.deleted = append(g.deleted, entry)
+		return nil
+	}
+
+	g.refused = append(g.refused, entry)
+
+	return fmt.Errorf("refusing to delete %s: %d users and groups have already been deleted in this apply, "+
+		"which is the provider's max_deletions_per_apply. Deleted: %s. Refused: %s",
+		entry, len(g.deleted), strings.Join(g.deleted, ", "), strings.Join(g.refused, ", "))
+}
+
+// userProtection holds the provider's settings for users it refuses to delete or suspend
+type userProtection struct {
+	protectedUsers     []string
+	protectedOrgUnits  []string
+	protectSuperAdmins bool
+}
+
+// checkUser returns an error when the user is protected, explaining which setting protects it
+func (p *userProtection) checkUser(action, primaryEmail, orgUnitPath string, isAdmin bool) error {
+	if p == nil {
+		return nil
+	}
+
+	if p.protectSuperAdmins && isAdmin {
+		return fmt.Errorf("refusing to %s user %s: the user is a super admin, and protect_super_admins "+
+			"is set in the provider", action, primaryEmail)
+	}
+
+	for _, pattern := range p.protectedUsers {
+		// patterns are validated when configuring the provider
+		if ok, _ := path.Match(strings.ToLower(pattern), strings.ToLower(primaryEmail)); ok {
+			return fmt.Errorf("refusing to %s user %s: the user matches %q in the provider's protected_users",
+				action, primaryEmail, pattern)
+		}
+	}
+
+	for _, ou := range p.protectedOrgUnits {
+		if isOrgUnitPathWithin(orgUnitPath, ou) {
+			return fmt.Errorf("refusing to %s user %s: the user's org unit %s is within %q in the provider's "+
+				"protected_org_units", action, primaryEmail, orgUnitPath, ou)
+		}
+	}
+
+	return nil
+}
+
+// checkLiveUser fetches the user and checks whether it is protected, so that the protection applies to the
+// user's current primary email, org unit and super admin status rather than to the values in the state
+func (p *userProtection) checkLiveUser(usersService *directory.UsersService, action, userKey string) error {
+	if p == nil || (!p.protectSuperAdmins && len(p.protectedUsers) == 0 && len(p.protectedOrgUnits) == 0) {
+		return nil
+	}
+
+	user, err := usersService.Get(userKey).Fields("primaryEmail", "orgUnitPath", "isAdmin").Do()
+	if isNotFound(err) {
+		// there is nothing left to protect
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error retrieving user %s to check whether it is protected: %s", userKey, err)
+	}
+
+	return p.checkUser(action, user.PrimaryEmail, user.OrgUnitPath, user.IsAdmin)
+}
+
+// isOrgUnitPathWithin returns whether the org unit path is the parent path, or one of its children
+func isOrgUnitPathWithin(orgUnitPath, parent string) bool {
+	orgUnitPath = strings.ToLower(strings.TrimRight(orgUnitPath, "/"))
+	parent = strings.ToLower(strings.TrimRight(parent, "/"))
+
+	if parent == "" {
+		return true
+	}
+
+	return orgUnitPath == parent || strings.HasPrefix(orgUnitPath, parent+"/")
+}
@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestUserProtection_checkUser(t *testing.T) {
+	protection := &userProtection{
+		protectedUsers:     []string{"*-admin@example.com", "ceo@example.com"},
+		protectedOrgUnits:  []string{"/Executives/"},
+		protectSuperAdmins: true,
+	}
+
+	cases := map[string]struct {
+		primaryEmail string
+		orgUnitPath  string
+		isAdmin      bool
+		protected    bool
+	}{
+		"pattern":          {"Break-Glass-Admin@example.com", "/", false, true},
+		"exact email":      {"ceo@example.com", "/", false, true},
+		"org unit":         {"michael@example.com", "/executives", false, true},
+		"child org unit":   {"jan@example.com", "/Executives/Corporate", false, true},
+		"similar org unit": {"jim@example.com", "/Executives-Assistants", false, false},
+		"super admin":      {"toby@example.com", "/HR", true, true},
+		"unprotected":      {"dwight@example.com", "/Sales", false, false},
+	}
+
+	for name, tc := range cases {
+		err := protection.checkUser("delete", tc.primaryEmail, tc.orgUnitPath, tc.isAdmin)
+		if tc.protected && err == nil {
+			t.Errorf("%s: expected user %s to be protected", name, tc.primaryEmail)
+		}
+		if !tc.protected && err != nil {
+			t.Errorf("%s: expected user %s not to be protected, got: %v", name, tc.primaryEmail, err)
+		}
+	}
+
+	var noProtection *userProtection
+	if err := noProtection.checkUser("delete", "ceo@example.com", "/", true); err != nil {
+		t.Errorf("expected no error without protection settings, got: %v", err)
+	}
+}
+
+func TestDeletionGuard_reserveDeletion(t *testing.T) {
+	guard := &deletionGuard{maxDeletions: 2}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 4)
+	for _, name := range []string{"jim@example.com", "pam@example.com", "dwight@example.com", "angela@example.com"} {
+		wg.Add(1)
+		go func(name string) {
+			defer wg.Done()
+			errs <- guard.reserveDeletion("user", name)
+		}(name)
+	}
+	wg.Wait()
+	close(errs)
+
+	var refused []error
+	for err := range errs {
+		if err != nil {
+			refused = append(refused, err)
+		}
+	}
+
+	if len(refused) != 2 {
+		t.Fatalf("expected 2 deletions to be refused, got %d", len(refused))
+	}
+
+	if err := guard.reserveDeletion("group", "sales@example.com"); err == nil || !strings.Contains(err.Error(), "group sales@example.com") {
+		t.Errorf("expected the refused group to be listed, got: %v", err)
+	}
+
+	unlimited := &deletionGuard{}
+	for i := 0; i < 10; i++ {
+		if err := unlimited.reserveDeletion("user", "jim@example.com"); err != nil {
+			t.Fatalf("expected no limit, got: %v", err)
+		}
+	}
+}
+
+func TestDeletionGuard_planDeletions(t *testing.T) {
+	guard := &deletionGuard{maxDeletions: 2}
+
+	if err := guard.planDeletions("user", "jim@example.com", "pam@example.com"); err != nil {
+		t.Fatalf("expected the deletions to be planned, got: %v", err)
+	}
+
+	// planning the same deletions again doesn't count them twice
+	if err := guard.planDeletions("user", "jim@example.com"); err != nil {
+		t.Fatalf("expected the deletion to be planned once, got: %v", err)
+	}
+
+	err := guard.planDeletions("group", "sales@example.com")
+	if err == nil {
+		t.Fatal("expected the plan to be refused")
+	}
+
+	for _, entry := range []string{"user jim@example.com", "user pam@example.com", "group sales@example.com"} {
+		if !strings.Contains(err.Error(), entry) {
+			t.Errorf("expected %q to be listed, got: %v", entry, err)
+		}
+	}
+
+	if len(guard.deleted) != 0 {
+		t.Errorf("expected planned deletions not to count as deleted, got: %v", guard.deleted)
+	}
+
+	unlimited := &deletionGuard{}
+	if err := unlimited.planDeletions("user", "jim@example.com", "pam@example.com", "dwight@example.com"); err != nil {
+		t.Fatalf("expected no limit, got: %v", err)
+	}
+}
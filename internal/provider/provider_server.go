@@ -0,0 +1,170 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"context"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/go-cty/cty/msgpack"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// NewProviderServer returns the provider's gRPC server. It wraps the SDK's server so that Terraform plans
+// destroys, which lets the deletions of users and groups be counted against the provider's
+// max_deletions_per_apply before anything is deleted.
+func NewProviderServer(version string) func() tfprotov5.ProviderServer {
+	return func() tfprotov5.ProviderServer {
+		p := New(version)()
+
+		return &providerServer{
+			ProviderServer: schema.NewGRPCProviderServer(p),
+			provider:       p,
+		}
+	}
+}
+
+type providerServer struct {
+	tfprotov5.ProviderServer
+
+	provider *schema.Provider
+}
+
+func (s *providerServer) GetMetadata(ctx context.Context, req *tfprotov5.GetMetadataRequest) (*tfprotov5.GetMetadataResponse, error) {
+	resp, err := s.ProviderServer.GetMetadata(ctx, req)
+	if err != nil || resp == nil {
+		return resp, err
+	}
+
+	if resp.ServerCapabilities == nil {
+		resp.ServerCapabilities = &tfprotov5.ServerCapabilities{}
+	}
+	resp.ServerCapabilities.PlanDestroy = true
+
+	return resp, nil
+}
+
+func (s *providerServer) GetProviderSchema(ctx context.Context, req *tfprotov5.GetProviderSchemaRequest) (*tfprotov5.GetProviderSchemaResponse, error) {
+	resp, err := s.ProviderServer.GetProviderSchema(ctx, req)
+	if err != nil || resp == nil {
+		return resp, err
+	}
+
+	if resp.ServerCapabilities == nil {
+		resp.ServerCapabilities = &tfprotov5.ServerCapabilities{}
+	}
+	resp.ServerCapabilities.PlanDestroy = true
+
+	return resp, nil
+}
+
+func (s *providerServer) PlanResourceChange(ctx context.Context, req *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	resp, err := s.ProviderServer.PlanResourceChange(ctx, req)
+	if err != nil || resp == nil || hasErrorDiagnostics(resp.Diagnostics) {
+		return resp, err
+	}
+
+	client, ok := s.provider.Meta().(*apiClient)
+	if !ok || client == nil {
+		return resp, nil
+	}
+
+	resourceType, names, err := s.plannedDeletions(req, resp)
+	if err != nil {
+		log.Printf("[WARN] Could not check the planned deletions of %s: %s", req.TypeName, err)
+		return resp, nil
+	}
+
+	if len(names) == 0 {
+		return resp, nil
+	}
+
+	log.Printf("[DEBUG] Planned deletion of %d %ss: %v", len(names), resourceType, names)
+
+	if err := client.deletionGuard.planDeletions(resourceType, names...); err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  err.Error(),
+		})
+	}
+
+	return resp, nil
+}
+
+// plannedDeletions returns the users or groups that the planned change deletes, suspends or archives, if
+// any. Both destroys and replacements delete the resource. The users that users_bulk suspends when it is
+// updated are counted by its CustomizeDiff.
+func (s *providerServer) plannedDeletions(req *tfprotov5.PlanResourceChangeRequest, resp *tfprotov5.PlanResourceChangeResponse) (string, []string, error) {
+	switch req.TypeName {
+	case "googleworkspace_user", "googleworkspace_group", "googleworkspace_users_bulk":
+	default:
+		return "", nil, nil
+	}
+
+	if req.PriorState == nil || req.ProposedNewState == nil {
+		return "", nil, nil
+	}
+
+	ty := s.provider.ResourcesMap[req.TypeName].CoreConfigSchema().ImpliedType()
+
+	prior, err := msgpack.Unmarshal(req.PriorState.MsgPack, ty)
+	if err != nil {
+		return "", nil, err
+	}
+
+	proposed, err := msgpack.Unmarshal(req.ProposedNewState.MsgPack, ty)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if prior.IsNull() || (!proposed.IsNull() && len(resp.RequiresReplace) == 0) {
+		return "", nil, nil
+	}
+
+	switch req.TypeName {
+	case "googleworkspace_user":
+		if ctyString(prior.GetAttr("deletion_policy")) == "ABANDON" {
+			return "", nil, nil
+		}
+
+		return "user", []string{ctyString(prior.GetAttr("primary_email"))}, nil
+	case "googleworkspace_group":
+		return "group", []string{ctyString(prior.GetAttr("email"))}, nil
+	}
+
+	suspendOnDestroy := prior.GetAttr("suspend_on_destroy")
+	managed := prior.GetAttr("managed_users")
+	if suspendOnDestroy.IsNull() || !suspendOnDestroy.True() || managed.IsNull() || !managed.IsKnown() {
+		return "", nil, nil
+	}
+
+	keys := []string{}
+	for key := range managed.AsValueMap() {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return "user", keys, nil
+}
+
+func ctyString(v cty.Value) string {
+	if v.IsNull() || !v.IsKnown() || v.Type() != cty.String {
+		return ""
+	}
+
+	return v.AsString()
+}
+
+func hasErrorDiagnostics(diags []*tfprotov5.Diagnostic) bool {
+	for _, d := range diags {
+		if d != nil && d.Severity == tfprotov5.DiagnosticSeverityError {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/go-cty/cty/msgpack"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+)
+
+func TestProviderServer_planDestroy(t *testing.T) {
+	server := NewProviderServer("dev")().(*providerServer)
+
+	resp, err := server.GetProviderSchema(context.Background(), &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.ServerCapabilities == nil || !resp.ServerCapabilities.PlanDestroy {
+		t.Fatal("expected the server to plan destroys")
+	}
+
+	server.provider.SetMeta(&apiClient{deletionGuard: &deletionGuard{maxDeletions: 2}})
+
+	destroys := []struct {
+		typeName string
+		attrs    map[string]cty.Value
+		refused  bool
+	}{
+		{"googleworkspace_group", map[string]cty.Value{"email": cty.StringVal("sales@example.com")}, false},
+		{"googleworkspace_user", map[string]cty.Value{
+			"primary_email":   cty.StringVal("jim@example.com"),
+			"deletion_policy": cty.StringVal("ABANDON"),
+		}, false},
+		{"googleworkspace_user", map[string]cty.Value{
+			"primary_email":   cty.StringVal("pam@example.com"),
+			"deletion_policy": cty.StringVal("SUSPEND"),
+		}, false},
+		{"googleworkspace_user", map[string]cty.Value{
+			"primary_email":   cty.StringVal("dwight@example.com"),
+			"deletion_policy": cty.StringVal("DELETE"),
+		}, true},
+	}
+
+	for _, tc := range destroys {
+		resp, err := server.PlanResourceChange(context.Background(), testPlanDestroyRequest(t, server, tc.typeName, tc.attrs))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if hasErrorDiagnostics(resp.Diagnostics) != tc.refused {
+			t.Fatalf("expected refused to be %t for %s, got: %v", tc.refused, tc.attrs, resp.Diagnostics)
+		}
+
+		if tc.refused && !strings.Contains(resp.Diagnostics[0].Summary, "group sales@example.com") {
+			t.Errorf("expected the planned deletions to be listed, got: %s", resp.Diagnostics[0].Summary)
+		}
+	}
+}
+
+func testPlanDestroyRequest(t *testing.T, server *providerServer, typeName string, attrs map[string]cty.Value) *tfprotov5.PlanResourceChangeRequest {
+	ty := server.provider.ResourcesMap[typeName].CoreConfigSchema().ImpliedType()
+
+	vals := map[string]cty.Value{}
+	for name, attrType := range ty.AttributeTypes() {
+		vals[name] = cty.NullVal(attrType)
+		if v, ok := attrs[name]; ok {
+			vals[name] = v
+		}
+	}
+
+	prior, err := msgpack.Marshal(cty.ObjectVal(vals), ty)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	proposed, err := msgpack.Marshal(cty.NullVal(ty), ty)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &tfprotov5.PlanResourceChangeRequest{
+		TypeName:         typeName,
+		PriorState:       &tfprotov5.DynamicValue{MsgPack: prior},
+		ProposedNewState: &tfprotov5.DynamicValue{MsgPack: proposed},
+		Config:           &tfprotov5.DynamicValue{MsgPack: proposed},
+	}
+}
@@ -0,0 +1,521 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"google.golang.org/api/chromepolicy/v1"
+)
+
+const (
+	chromeAppInstallTypeSchema = "chrome.users.apps.InstallType"
+	chromeAppPermissionsSchema = "chrome.users.apps.PermissionsAndUrlAccess"
+	chromeAppManagedConfSchema = "chrome.users.apps.ManagedConfiguration"
+
+	chromeAppForcedAndPinnedInstallType = "FORCED_AND_PIN_TO_TOOLBAR"
+)
+
+// maps the permissions and url access attributes to their policy field names
+var chromeAppPermissionsFields = map[string]string{
+	"allowed_permissions": "allowedPermissions",
+	"blocked_permissions": "blockedPermissions",
+	"allowed_hosts":       "allowedHosts",
+	"blocked_hosts":       "blockedHosts",
+}
+
+func resourceChromeApp() *schema.Resource {
+	return &schema.Resource{
+		Description: "Chrome App resource in the Terraform Googleworkspace provider. Manages the installation " +
+			"and settings of a Chrome app, extension, Android app or web app for an org unit, by translating its " +
+			"settings into the `chrome.users.apps.*` policy schemas. The app is validated against the Chrome " +
+			"Management app details API, which resides under the " +
+			"`https://www.googleapis.com/auth/chrome.management.appdetails.readonly` client scope. The policies " +
+			"reside under the `https://www.googleapis.com/auth/chrome.management.policy` client scope.",
+
+		CreateContext: resourceChromeAppCreate,
+		ReadContext:   resourceChromeAppRead,
+		UpdateContext: resourceChromeAppUpdate,
+		DeleteContext: resourceChromeAppDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceChromeAppImport,
+		},
+
+		CustomizeDiff: resourceChromeAppCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"org_unit_id": {
+				Description:      "The org unit the app is managed in.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: diffSuppressOrgUnitId,
+			},
+			"app_id": {
+				Description: "The app identifier, prefixed by the app type, e.g. " +
+					"`chrome:aapocclcgogkmnckokdopfmhonfmgoek`, `android:com.google.android.gm` or " +
+					"`web:https://example.com`.",
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.StringMatch(regexp.MustCompile(`^(chrome|android|web):.+$`), "app_id must be prefixed by one of chrome:, android: or web:"),
+				),
+			},
+			"install_type": {
+				Description: "The install type of the app. One of `ALLOWED`, `BLOCKED` or `FORCED`.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.StringInSlice([]string{"ALLOWED", "BLOCKED", "FORCED"}, false),
+				),
+			},
+			"pinned": {
+				Description: "Whether a force installed extension is pinned to the browser toolbar. " +
+					"Requires `install_type` to be `FORCED`.",
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"allowed_permissions": {
+				Description: "Permissions the app is allowed to use, overriding `blocked_permissions`.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"blocked_permissions": {
+				Description: "Permissions the app is not allowed to use.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"allowed_hosts": {
+				Description: "URL patterns the app is allowed to interact with, overriding `blocked_hosts`.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"blocked_hosts": {
+				Description: "URL patterns the app is not allowed to interact with.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"managed_configuration": {
+				Description: "JSON encoded managed configuration applied to the app.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.StringIsJSON,
+				),
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+			},
+			"display_name": {
+				Description: "App's display name, as reported by the app details API.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceChromeAppCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.Get("pinned").(bool) && diff.Get("install_type").(string) != "FORCED" {
+		return fmt.Errorf("pinned can only be set when install_type is FORCED")
+	}
+
+	return nil
+}
+
+func resourceChromeAppCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	orgUnitId := strings.TrimPrefix(d.Get("org_unit_id").(string), "id:")
+	appId := d.Get("app_id").(string)
+
+	log.Printf("[DEBUG] Creating Chrome App %s for org:%s", appId, orgUnitId)
+
+	displayName, diags := getChromeAppDisplayName(ctx, client, appId)
+	if diags.HasError() {
+		return diags
+	}
+
+	policyTargetKey := chromeAppPolicyTargetKey(orgUnitId, appId)
+
+	requests, diags := expandChromeAppPolicyRequests(d, policyTargetKey, false)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags = batchModifyChromeAppPolicies(ctx, client, requests)
+	if diags.HasError() {
+		return diags
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", orgUnitId, appId))
+	d.Set("display_name", displayName)
+
+	log.Printf("[DEBUG] Finished creating Chrome App %s for org:%s", appId, orgUnitId)
+
+	return resourceChromeAppRead(ctx, d, meta)
+}
+
+func resourceChromeAppUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	orgUnitId, appId := parseChromeAppId(d.Id())
+
+	log.Printf("[DEBUG] Updating Chrome App %s for org:%s", appId, orgUnitId)
+
+	requests, diags := expandChromeAppPolicyRequests(d, chromeAppPolicyTargetKey(orgUnitId, appId), true)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags = batchModifyChromeAppPolicies(ctx, client, requests)
+	if diags.HasError() {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Finished updating Chrome App %s for org:%s", appId, orgUnitId)
+
+	return resourceChromeAppRead(ctx, d, meta)
+}
+
+func resourceChromeAppRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	chromePolicyService, diags := client.NewChromePolicyService()
+	if diags.HasError() {
+		return diags
+	}
+
+	chromePoliciesService, diags := GetChromePoliciesService(chromePolicyService)
+	if diags.HasError() {
+		return diags
+	}
+
+	orgUnitId, appId := parseChromeAppId(d.Id())
+
+	log.Printf("[DEBUG] Getting Chrome App %s for org:%s", appId, orgUnitId)
+
+	policyTargetKey := chromeAppPolicyTargetKey(orgUnitId, appId)
+
+	var resolved []*chromepolicy.GoogleChromePolicyVersionsV1ResolvedPolicy
+	err := retryTimeDuration(ctx, time.Minute, func() error {
+		resolved = nil
+
+		return chromePoliciesService.Resolve(fmt.Sprintf("customers/%s", client.Customer), &chromepolicy.GoogleChromePolicyVersionsV1ResolveRequest{
+			PolicySchemaFilter: "chrome.users.apps.*",
+			PolicyTargetKey:    policyTargetKey,
+		}).Pages(ctx, func(resp *chromepolicy.GoogleChromePolicyVersionsV1ResolveResponse) error {
+			resolved = append(resolved, resp.ResolvedPolicies...)
+			return nil
+		})
+	})
+	if err != nil {
+		return handleNotFoundError(err, d, d.Id())
+	}
+
+	values, added, err := chromeAppTargetValues(resolved, policyTargetKey.TargetResource)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// the app is only managed by this resource if it was explicitly added to this org unit
+	if !added {
+		log.Printf("[WARN] Removing Chrome App %s for org:%s because it's gone", appId, orgUnitId)
+		d.SetId("")
+		return nil
+	}
+
+	installType, _ := values[chromeAppInstallTypeSchema]["appInstallType"].(string)
+	pinned := false
+	if installType == chromeAppForcedAndPinnedInstallType {
+		installType = "FORCED"
+		pinned = true
+	}
+
+	d.Set("org_unit_id", orgUnitId)
+	d.Set("app_id", appId)
+	d.Set("install_type", installType)
+	d.Set("pinned", pinned)
+
+	for attr, field := range chromeAppPermissionsFields {
+		var list []interface{}
+		if v, ok := values[chromeAppPermissionsSchema][field].([]interface{}); ok {
+			list = v
+		}
+		if err := d.Set(attr, list); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	managedConfiguration, _ := values[chromeAppManagedConfSchema]["managedConfiguration"].(string)
+	d.Set("managed_configuration", managedConfiguration)
+
+	// the display name is not part of the policies, it is only looked up when unknown, e.g. after an import
+	if d.Get("display_name").(string) == "" {
+		displayName, diags := getChromeAppDisplayName(ctx, client, appId)
+		if diags.HasError() {
+			return diags
+		}
+		d.Set("display_name", displayName)
+	}
+
+	log.Printf("[DEBUG] Finished getting Chrome App %s for org:%s", appId, orgUnitId)
+
+	return nil
+}
+
+func resourceChromeAppDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	chromePolicyService, diags := client.NewChromePolicyService()
+	if diags.HasError() {
+		return diags
+	}
+
+	chromePoliciesService, diags := GetChromePoliciesService(chromePolicyService)
+	if diags.HasError() {
+		return diags
+	}
+
+	orgUnitId, appId := parseChromeAppId(d.Id())
+
+	log.Printf("[DEBUG] Deleting Chrome App %s for org:%s", appId, orgUnitId)
+
+	// inheriting all app schemas in the org unit it was added to removes the app from management
+	requests := []*chromepolicy.GoogleChromePolicyVersionsV1InheritOrgUnitPolicyRequest{
+		{
+			PolicyTargetKey: chromeAppPolicyTargetKey(orgUnitId, appId),
+			PolicySchema:    "chrome.users.apps.*",
+		},
+	}
+
+	err := retryTimeDuration(ctx, time.Minute, func() error {
+		_, retryErr := chromePoliciesService.Orgunits.BatchInherit(fmt.Sprintf("customers/%s", client.Customer), &chromepolicy.GoogleChromePolicyVersionsV1BatchInheritOrgUnitPoliciesRequest{Requests: requests}).Do()
+		return retryErr
+	})
+	if err != nil {
+		return handleNotFoundError(err, d, d.Id())
+	}
+
+	log.Printf("[DEBUG] Finished deleting Chrome App %s for org:%s", appId, orgUnitId)
+
+	return nil
+}
+
+func resourceChromeAppImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	orgUnitId, appId := parseChromeAppId(d.Id())
+	if orgUnitId == "" || appId == "" {
+		return nil, fmt.Errorf("Import Id is not of the format {org_unit_id}/{app_id}: %s", d.Id())
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", strings.TrimPrefix(orgUnitId, "id:"), appId))
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// The ID is of the format {org_unit_id}/{app_id}, where the app id itself may contain slashes (web apps)
+func parseChromeAppId(id string) (string, string) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 {
+		return "", ""
+	}
+
+	return parts[0], parts[1]
+}
+
+// chromeAppTargetValues returns the resolved values of the app schemas that are set on the target resource itself,
+// values inherited from parent org units are ignored. It also returns whether the app was added to the target resource
+func chromeAppTargetValues(resolved []*chromepolicy.GoogleChromePolicyVersionsV1ResolvedPolicy, targetResource string) (map[string]map[string]interface{}, bool, error) {
+	values := map[string]map[string]interface{}{}
+	added := false
+	for _, rp := range resolved {
+		if rp.Value == nil {
+			continue
+		}
+
+		if rp.Value.PolicySchema == chromeAppInstallTypeSchema && rp.AddedSourceKey != nil &&
+			rp.AddedSourceKey.TargetResource == targetResource {
+			added = true
+		}
+
+		if rp.SourceKey == nil || rp.SourceKey.TargetResource != targetResource {
+			continue
+		}
+
+		var value map[string]interface{}
+		if err := json.Unmarshal(rp.Value.Value, &value); err != nil {
+			return nil, false, err
+		}
+		values[rp.Value.PolicySchema] = value
+	}
+
+	return values, added, nil
+}
+
+func chromeAppPolicyTargetKey(orgUnitId, appId string) *chromepolicy.GoogleChromePolicyVersionsV1PolicyTargetKey {
+	return &chromepolicy.GoogleChromePolicyVersionsV1PolicyTargetKey{
+		TargetResource:       "orgunits/" + orgUnitId,
+		AdditionalTargetKeys: map[string]string{"app_id": appId},
+	}
+}
+
+// getChromeAppDisplayName ensures the app exists using the Chrome Management app details API
+func getChromeAppDisplayName(ctx context.Context, client *apiClient, appId string) (string, diag.Diagnostics) {
+	chromeManagementService, diags := client.NewChromeManagementService()
+	if diags.HasError() {
+		return "", diags
+	}
+
+	appsService, diags := GetChromeManagementAppsService(chromeManagementService)
+	if diags.HasError() {
+		return "", diags
+	}
+
+	parts := strings.SplitN(appId, ":", 2)
+	appType, id := parts[0], url.PathEscape(parts[1])
+	name := fmt.Sprintf("customers/%s/apps/%s/%s", client.Customer, appType, id)
+
+	var displayName string
+	var err error
+	switch appType {
+	case "chrome":
+		app, getErr := appsService.Chrome.Get(name).Context(ctx).Do()
+		if getErr == nil {
+			displayName = app.DisplayName
+		}
+		err = getErr
+	case "android":
+		app, getErr := appsService.Android.Get(name).Context(ctx).Do()
+		if getErr == nil {
+			displayName = app.DisplayName
+		}
+		err = getErr
+	case "web":
+		app, getErr := appsService.Web.Get(name).Context(ctx).Do()
+		if getErr == nil {
+			displayName = app.DisplayName
+		}
+		err = getErr
+	default:
+		return "", diag.Errorf("unsupported app type %q for app %s", appType, appId)
+	}
+
+	if err != nil {
+		if isApiErrorWithCode(err, 404) {
+			return "", diag.Errorf("app %s was not found", appId)
+		}
+		return "", diag.FromErr(err)
+	}
+
+	return displayName, nil
+}
+
+func expandChromeAppPolicyRequests(d *schema.ResourceData, policyTargetKey *chromepolicy.GoogleChromePolicyVersionsV1PolicyTargetKey, update bool) ([]*chromepolicy.GoogleChromePolicyVersionsV1ModifyOrgUnitPolicyRequest, diag.Diagnostics) {
+	var requests []*chromepolicy.GoogleChromePolicyVersionsV1ModifyOrgUnitPolicyRequest
+
+	addRequest := func(schemaName string, values map[string]interface{}) diag.Diagnostics {
+		var keys []string
+		for k := range values {
+			keys = append(keys, k)
+		}
+
+		valueJson, err := json.Marshal(values)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		requests = append(requests, &chromepolicy.GoogleChromePolicyVersionsV1ModifyOrgUnitPolicyRequest{
+			PolicyTargetKey: policyTargetKey,
+			PolicyValue: &chromepolicy.GoogleChromePolicyVersionsV1PolicyValue{
+				PolicySchema: schemaName,
+				Value:        valueJson,
+			},
+			UpdateMask: strings.Join(keys, ","),
+		})
+
+		return nil
+	}
+
+	if !update || d.HasChanges("install_type", "pinned") {
+		installType := d.Get("install_type").(string)
+		if d.Get("pinned").(bool) {
+			installType = chromeAppForcedAndPinnedInstallType
+		}
+
+		if diags := addRequest(chromeAppInstallTypeSchema, map[string]interface{}{"appInstallType": installType}); diags.HasError() {
+			return nil, diags
+		}
+	}
+
+	permissions := map[string]interface{}{}
+	for attr, field := range chromeAppPermissionsFields {
+		// unset fields are only sent when they were removed from the configuration
+		set := d.Get(attr).(*schema.Set)
+		if set.Len() > 0 || (update && d.HasChange(attr)) {
+			permissions[field] = set.List()
+		}
+	}
+	if len(permissions) > 0 {
+		if diags := addRequest(chromeAppPermissionsSchema, permissions); diags.HasError() {
+			return nil, diags
+		}
+	}
+
+	if v := d.Get("managed_configuration").(string); v != "" || (update && d.HasChange("managed_configuration")) {
+		if diags := addRequest(chromeAppManagedConfSchema, map[string]interface{}{"managedConfiguration": v}); diags.HasError() {
+			return nil, diags
+		}
+	}
+
+	return requests, nil
+}
+
+func batchModifyChromeAppPolicies(ctx context.Context, client *apiClient, requests []*chromepolicy.GoogleChromePolicyVersionsV1ModifyOrgUnitPolicyRequest) diag.Diagnostics {
+	if len(requests) == 0 {
+		return nil
+	}
+
+	chromePolicyService, diags := client.NewChromePolicyService()
+	if diags.HasError() {
+		return diags
+	}
+
+	chromePoliciesService, diags := GetChromePoliciesService(chromePolicyService)
+	if diags.HasError() {
+		return diags
+	}
+
+	err := retryTimeDuration(ctx, time.Minute, func() error {
+		_, retryErr := chromePoliciesService.Orgunits.BatchModify(fmt.Sprintf("customers/%s", client.Customer), &chromepolicy.GoogleChromePolicyVersionsV1BatchModifyOrgUnitPoliciesRequest{Requests: requests}).Do()
+		return retryErr
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"google.golang.org/api/chromepolicy/v1"
+)
+
+func TestAccResourceChromeApp_basic(t *testing.T) {
+	t.Parallel()
+
+	ouName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceChromeApp_forced(ouName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_chrome_app.test", "install_type", "FORCED"),
+					resource.TestCheckResourceAttr("googleworkspace_chrome_app.test", "pinned", "true"),
+					resource.TestCheckResourceAttrSet("googleworkspace_chrome_app.test", "display_name"),
+				),
+			},
+			{
+				ResourceName:            "googleworkspace_chrome_app.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"org_unit_id"},
+			},
+			{
+				Config: testAccResourceChromeApp_allowed(ouName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_chrome_app.test", "install_type", "ALLOWED"),
+					resource.TestCheckResourceAttr("googleworkspace_chrome_app.test", "pinned", "false"),
+					resource.TestCheckResourceAttr("googleworkspace_chrome_app.test", "blocked_hosts.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceChromeApp_forced(ouName string) string {
+	return fmt.Sprintf(`
+resource "googleworkspace_org_unit" "test" {
+  name                 = "%s"
+  parent_org_unit_path = "/"
+}
+
+resource "googleworkspace_chrome_app" "test" {
+  org_unit_id  = googleworkspace_org_unit.test.id
+  app_id       = "chrome:aapocclcgogkmnckokdopfmhonfmgoek"
+  install_type = "FORCED"
+  pinned       = true
+}
+`, ouName)
+}
+
+func testAccResourceChromeApp_allowed(ouName string) string {
+	return fmt.Sprintf(`
+resource "googleworkspace_org_unit" "test" {
+  name                 = "%s"
+  parent_org_unit_path = "/"
+}
+
+resource "googleworkspace_chrome_app" "test" {
+  org_unit_id   = googleworkspace_org_unit.test.id
+  app_id        = "chrome:aapocclcgogkmnckokdopfmhonfmgoek"
+  install_type  = "ALLOWED"
+  blocked_hosts = ["*://*.example.com"]
+}
+`, ouName)
+}
+
+func TestResourceChromeApp_targetValues(t *testing.T) {
+	target := "orgunits/child"
+	resolvedPolicy := func(schema, source, added, value string) *chromepolicy.GoogleChromePolicyVersionsV1ResolvedPolicy {
+		return &chromepolicy.GoogleChromePolicyVersionsV1ResolvedPolicy{
+			AddedSourceKey: &chromepolicy.GoogleChromePolicyVersionsV1PolicyTargetKey{TargetResource: added},
+			SourceKey:      &chromepolicy.GoogleChromePolicyVersionsV1PolicyTargetKey{TargetResource: source},
+			Value: &chromepolicy.GoogleChromePolicyVersionsV1PolicyValue{
+				PolicySchema: schema,
+				Value:        []byte(value),
+			},
+		}
+	}
+
+	values, added, err := chromeAppTargetValues([]*chromepolicy.GoogleChromePolicyVersionsV1ResolvedPolicy{
+		resolvedPolicy(chromeAppInstallTypeSchema, target, target, `{"appInstallType": "FORCED"}`),
+		resolvedPolicy(chromeAppManagedConfSchema, "orgunits/parent", "orgunits/parent", `{"managedConfiguration": "{}"}`),
+	}, target)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !added {
+		t.Errorf("expected the app to be added to %s", target)
+	}
+
+	if values[chromeAppInstallTypeSchema]["appInstallType"] != "FORCED" {
+		t.Errorf("expected the install type of %s, got %v", target, values[chromeAppInstallTypeSchema])
+	}
+
+	if _, ok := values[chromeAppManagedConfSchema]; ok {
+		t.Errorf("expected the managed configuration inherited from the parent org unit to be ignored, got %v", values[chromeAppManagedConfSchema])
+	}
+
+	_, added, err = chromeAppTargetValues([]*chromepolicy.GoogleChromePolicyVersionsV1ResolvedPolicy{
+		resolvedPolicy(chromeAppInstallTypeSchema, "orgunits/parent", "orgunits/parent", `{"appInstallType": "FORCED"}`),
+	}, target)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if added {
+		t.Errorf("expected an app added to the parent org unit not to be added to %s", target)
+	}
+}
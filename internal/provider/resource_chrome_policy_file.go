@@ -0,0 +1,172 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mitchellh/go-homedir"
+	"google.golang.org/api/chromepolicy/v1"
+)
+
+func resourceChromePolicyFile() *schema.Resource {
+	return &schema.Resource{
+		Description: "Chrome Policy File resource in the Terraform Googleworkspace provider. Uploads a file for a " +
+			"Chrome policy field that requires one (e.g. wallpaper or avatar images), and exposes the resulting " +
+			"`download_uri` to be referenced in `googleworkspace_chrome_policy` schema values. The file is " +
+			"re-uploaded whenever its contents change. Uploaded files cannot be deleted through the API, " +
+			"so destroying this resource only removes it from state. Chrome Policy File resides under the " +
+			"`https://www.googleapis.com/auth/chrome.management.policy` client scope.",
+
+		CreateContext: resourceChromePolicyFileCreate,
+		ReadContext:   resourceChromePolicyFileRead,
+		DeleteContext: resourceChromePolicyFileDelete,
+
+		CustomizeDiff: resourceChromePolicyFileCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"policy_field": {
+				Description: "The fully qualified policy schema and field name this file is uploaded for, " +
+					"e.g. `chrome.users.Wallpaper.wallpaperImage`.",
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"source": {
+				Description: "The path to the local file to upload. Once uploaded, changes to the contents of the file " +
+					"upload a new file. If the file no longer exists, the uploaded file is kept.",
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"content_sha256": {
+				Description: "The SHA-256 hash of the uploaded file contents, used to detect changes to the local file.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"download_uri": {
+				Description: "The URI for end users to download the file.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceChromePolicyFileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	chromePolicyService, diags := client.NewChromePolicyService()
+	if diags.HasError() {
+		return diags
+	}
+
+	mediaService, diags := GetChromePolicyMediaService(chromePolicyService)
+	if diags.HasError() {
+		return diags
+	}
+
+	policyField := d.Get("policy_field").(string)
+	source := d.Get("source").(string)
+
+	log.Printf("[DEBUG] Uploading Chrome Policy File %q for field %s", source, policyField)
+
+	hash, err := chromePolicyFileSha256(source)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	path, err := homedir.Expand(source)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer file.Close()
+
+	resp, err := mediaService.Upload(fmt.Sprintf("customers/%s", client.Customer), &chromepolicy.GoogleChromePolicyVersionsV1UploadPolicyFileRequest{
+		PolicyField: policyField,
+	}).Media(file).Do()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(resp.DownloadUri)
+	d.Set("download_uri", resp.DownloadUri)
+	d.Set("content_sha256", hash)
+
+	log.Printf("[DEBUG] Finished uploading Chrome Policy File %q for field %s", source, policyField)
+
+	return resourceChromePolicyFileRead(ctx, d, meta)
+}
+
+// The API does not support reading uploaded files back, all values are kept in state
+func resourceChromePolicyFileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return nil
+}
+
+// The API does not support deleting uploaded files, the resource is only removed from state
+func resourceChromePolicyFileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] Removing Chrome Policy File %q from state, uploaded files cannot be deleted", d.Id())
+
+	return nil
+}
+
+func resourceChromePolicyFileCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	// new resources, or ones whose source path is changing, will be uploaded regardless
+	if diff.Id() == "" || diff.HasChange("source") {
+		return nil
+	}
+
+	hash, err := chromePolicyFileSha256(diff.Get("source").(string))
+	if os.IsNotExist(err) {
+		// the file may only have been needed for the upload, e.g. in a fresh checkout
+		log.Printf("[WARN] Chrome Policy File %q no longer exists, assuming the uploaded file is unchanged", diff.Get("source").(string))
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if hash != diff.Get("content_sha256").(string) {
+		if err := diff.SetNew("content_sha256", hash); err != nil {
+			return err
+		}
+
+		return diff.ForceNew("content_sha256")
+	}
+
+	return nil
+}
+
+func chromePolicyFileSha256(source string) (string, error) {
+	path, err := homedir.Expand(source)
+	if err != nil {
+		return "", err
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, file); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceChromePolicyFile_basic(t *testing.T) {
+	t.Parallel()
+
+	source := filepath.Join(t.TempDir(), "wallpaper.jpg")
+	testAccWriteChromePolicyFileImage(t, source, color.White)
+
+	var downloadUri string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceChromePolicyFile(source),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("googleworkspace_chrome_policy_file.test", "download_uri"),
+					resource.TestCheckResourceAttrSet("googleworkspace_chrome_policy_file.test", "content_sha256"),
+					testAccCheckChromePolicyFileDownloadUri("googleworkspace_chrome_policy_file.test", &downloadUri, false),
+				),
+			},
+			{
+				// changing the file contents should upload a new file
+				PreConfig: func() { testAccWriteChromePolicyFileImage(t, source, color.Black) },
+				Config:    testAccResourceChromePolicyFile(source),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChromePolicyFileDownloadUri("googleworkspace_chrome_policy_file.test", &downloadUri, true),
+				),
+			},
+			{
+				// a missing file doesn't fail the plan, and keeps the uploaded file
+				PreConfig: func() {
+					if err := os.Remove(source); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:   testAccResourceChromePolicyFile(source),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccWriteChromePolicyFileImage(t *testing.T, path string, c color.Color) {
+	img := image.NewRGBA(image.Rect(0, 0, 16, 16))
+	for x := 0; x < 16; x++ {
+		for y := 0; y < 16; y++ {
+			img.Set(x, y, c)
+		}
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	if err := jpeg.Encode(f, img, nil); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testAccCheckChromePolicyFileDownloadUri(n string, downloadUri *string, changed bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Can't find chrome policy file resource: %s", n)
+		}
+
+		uri := rs.Primary.Attributes["download_uri"]
+		if changed && uri == *downloadUri {
+			return fmt.Errorf("expected download_uri to change after the file was modified, got %s", uri)
+		}
+
+		*downloadUri = uri
+		return nil
+	}
+}
+
+func testAccResourceChromePolicyFile(source string) string {
+	return fmt.Sprintf(`
+resource "googleworkspace_chrome_policy_file" "test" {
+  policy_field = "chrome.users.Wallpaper.wallpaperImage"
+  source       = "%s"
+}
+`, source)
+}
@@ -0,0 +1,210 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	directory "google.golang.org/api/admin/directory/v1"
+)
+
+func resourceChromePrintServer() *schema.Resource {
+	return &schema.Resource{
+		Description: "Chrome Print Server resource in the Terraform Googleworkspace provider. Chrome Print Server resides " +
+			"under the `https://www.googleapis.com/auth/admin.chrome.printers` client scope.",
+
+		CreateContext: resourceChromePrintServerCreate,
+		ReadContext:   resourceChromePrintServerRead,
+		UpdateContext: resourceChromePrintServerUpdate,
+		DeleteContext: resourceChromePrintServerDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "ID of the print server.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"org_unit_id": {
+				Description: "The org unit that owns this print server. Can only be set during print server creation. " +
+					"If not set, the print server is placed under the root org unit.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: diffSuppressOrgUnitId,
+			},
+			"display_name": {
+				Description: "Name of the print server.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"description": {
+				Description: "Description of the print server.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"uri": {
+				Description: "Print server URI, e.g. `ipps://print-server.example.com:443`.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"create_time": {
+				Description: "Time when the print server was created.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceChromePrintServerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return diags
+	}
+
+	printServersService, diags := GetChromePrintServersService(directoryService)
+	if diags.HasError() {
+		return diags
+	}
+
+	displayName := d.Get("display_name").(string)
+	log.Printf("[DEBUG] Creating Chrome Print Server %q", displayName)
+
+	printServerObj := directory.PrintServer{
+		OrgUnitId:   strings.TrimPrefix(d.Get("org_unit_id").(string), "id:"),
+		DisplayName: displayName,
+		Description: d.Get("description").(string),
+		Uri:         d.Get("uri").(string),
+	}
+
+	printServer, err := printServersService.Create(fmt.Sprintf("customers/%s", client.Customer), &printServerObj).Do()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(printServer.Id)
+
+	log.Printf("[DEBUG] Finished creating Chrome Print Server %q: %s", d.Id(), displayName)
+
+	return resourceChromePrintServerRead(ctx, d, meta)
+}
+
+func resourceChromePrintServerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return diags
+	}
+
+	printServersService, diags := GetChromePrintServersService(directoryService)
+	if diags.HasError() {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Getting Chrome Print Server %q", d.Id())
+
+	printServer, err := printServersService.Get(chromePrintServerName(client.Customer, d.Id())).Do()
+	if err != nil {
+		return handleNotFoundError(err, d, d.Id())
+	}
+
+	d.SetId(printServer.Id)
+	d.Set("org_unit_id", printServer.OrgUnitId)
+	d.Set("display_name", printServer.DisplayName)
+	d.Set("description", printServer.Description)
+	d.Set("uri", printServer.Uri)
+	d.Set("create_time", printServer.CreateTime)
+
+	log.Printf("[DEBUG] Finished getting Chrome Print Server %q", d.Id())
+
+	return diags
+}
+
+func resourceChromePrintServerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return diags
+	}
+
+	printServersService, diags := GetChromePrintServersService(directoryService)
+	if diags.HasError() {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Updating Chrome Print Server %q", d.Id())
+
+	printServerObj := directory.PrintServer{}
+	var updateMask []string
+
+	if d.HasChange("display_name") {
+		printServerObj.DisplayName = d.Get("display_name").(string)
+		updateMask = append(updateMask, "displayName")
+	}
+
+	if d.HasChange("description") {
+		printServerObj.Description = d.Get("description").(string)
+		updateMask = append(updateMask, "description")
+	}
+
+	if d.HasChange("uri") {
+		printServerObj.Uri = d.Get("uri").(string)
+		updateMask = append(updateMask, "uri")
+	}
+
+	if len(updateMask) > 0 {
+		_, err := printServersService.Patch(chromePrintServerName(client.Customer, d.Id()), &printServerObj).UpdateMask(strings.Join(updateMask, ",")).Do()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	log.Printf("[DEBUG] Finished updating Chrome Print Server %q", d.Id())
+
+	return resourceChromePrintServerRead(ctx, d, meta)
+}
+
+func resourceChromePrintServerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return diags
+	}
+
+	printServersService, diags := GetChromePrintServersService(directoryService)
+	if diags.HasError() {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Deleting Chrome Print Server %q", d.Id())
+
+	_, err := printServersService.Delete(chromePrintServerName(client.Customer, d.Id())).Do()
+	if err != nil {
+		return handleNotFoundError(err, d, d.Id())
+	}
+
+	log.Printf("[DEBUG] Finished deleting Chrome Print Server %q", d.Id())
+
+	return diags
+}
+
+func chromePrintServerName(customer, id string) string {
+	return fmt.Sprintf("customers/%s/chrome/printServers/%s", customer, id)
+}
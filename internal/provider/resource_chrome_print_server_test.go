@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceChromePrintServer_basic(t *testing.T) {
+	t.Parallel()
+
+	ouName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceChromePrintServer(ouName, "tf-test-print-server"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_chrome_print_server.test", "display_name", "tf-test-print-server"),
+					resource.TestCheckResourceAttr("googleworkspace_chrome_print_server.test", "uri", "ipps://print-server.example.com:443"),
+					resource.TestCheckResourceAttrSet("googleworkspace_chrome_print_server.test", "create_time"),
+				),
+			},
+			{
+				ResourceName:            "googleworkspace_chrome_print_server.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"org_unit_id"},
+			},
+			{
+				Config: testAccResourceChromePrintServer(ouName, "tf-test-print-server-updated"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_chrome_print_server.test", "display_name", "tf-test-print-server-updated"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceChromePrintServer(ouName, displayName string) string {
+	return fmt.Sprintf(`
+resource "googleworkspace_org_unit" "test" {
+  name                 = "%s"
+  parent_org_unit_path = "/"
+}
+
+resource "googleworkspace_chrome_print_server" "test" {
+  org_unit_id  = googleworkspace_org_unit.test.id
+  display_name = "%s"
+  uri          = "ipps://print-server.example.com:443"
+}
+`, ouName, displayName)
+}
@@ -0,0 +1,236 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	directory "google.golang.org/api/admin/directory/v1"
+)
+
+func resourceChromePrinter() *schema.Resource {
+	return &schema.Resource{
+		Description: "Chrome Printer resource in the Terraform Googleworkspace provider. Chrome Printer resides " +
+			"under the `https://www.googleapis.com/auth/admin.chrome.printers` client scope.",
+
+		CreateContext: resourceChromePrinterCreate,
+		ReadContext:   resourceChromePrinterRead,
+		UpdateContext: resourceChromePrinterUpdate,
+		DeleteContext: resourceChromePrinterDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "ID of the printer.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"org_unit_id": {
+				Description:      "The org unit that owns this printer. Can only be set during printer creation.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: diffSuppressOrgUnitId,
+			},
+			"display_name": {
+				Description: "Name of the printer.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"description": {
+				Description: "Description of the printer.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"uri": {
+				Description: "Printer URI, e.g. `ipp://192.168.1.10:631/ipp/print`.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"make_and_model": {
+				Description: "Make and model of the printer, e.g. `Lexmark MS610de`. The value must be in the format " +
+					"returned by the `googleworkspace_chrome_printer_models` data source. Not required when " +
+					"`use_driverless_config` is `true`.",
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"use_driverless_config": {
+				Description: "Whether to use the driverless (IPP Everywhere) configuration. When set, " +
+					"`make_and_model` is ignored.",
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"create_time": {
+				Description: "Time when the printer was created.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceChromePrinterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return diags
+	}
+
+	printersService, diags := GetChromePrintersService(directoryService)
+	if diags.HasError() {
+		return diags
+	}
+
+	displayName := d.Get("display_name").(string)
+	log.Printf("[DEBUG] Creating Chrome Printer %q", displayName)
+
+	printerObj := directory.Printer{
+		OrgUnitId:           strings.TrimPrefix(d.Get("org_unit_id").(string), "id:"),
+		DisplayName:         displayName,
+		Description:         d.Get("description").(string),
+		Uri:                 d.Get("uri").(string),
+		MakeAndModel:        d.Get("make_and_model").(string),
+		UseDriverlessConfig: d.Get("use_driverless_config").(bool),
+	}
+
+	printer, err := printersService.Create(fmt.Sprintf("customers/%s", client.Customer), &printerObj).Do()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(printer.Id)
+
+	log.Printf("[DEBUG] Finished creating Chrome Printer %q: %s", d.Id(), displayName)
+
+	return resourceChromePrinterRead(ctx, d, meta)
+}
+
+func resourceChromePrinterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return diags
+	}
+
+	printersService, diags := GetChromePrintersService(directoryService)
+	if diags.HasError() {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Getting Chrome Printer %q", d.Id())
+
+	printer, err := printersService.Get(chromePrinterName(client.Customer, d.Id())).Do()
+	if err != nil {
+		return handleNotFoundError(err, d, d.Id())
+	}
+
+	d.SetId(printer.Id)
+	d.Set("org_unit_id", printer.OrgUnitId)
+	d.Set("display_name", printer.DisplayName)
+	d.Set("description", printer.Description)
+	d.Set("uri", printer.Uri)
+	d.Set("make_and_model", printer.MakeAndModel)
+	d.Set("use_driverless_config", printer.UseDriverlessConfig)
+	d.Set("create_time", printer.CreateTime)
+
+	log.Printf("[DEBUG] Finished getting Chrome Printer %q", d.Id())
+
+	return diags
+}
+
+func resourceChromePrinterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return diags
+	}
+
+	printersService, diags := GetChromePrintersService(directoryService)
+	if diags.HasError() {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Updating Chrome Printer %q", d.Id())
+
+	printerObj := directory.Printer{}
+	var updateMask []string
+
+	if d.HasChange("display_name") {
+		printerObj.DisplayName = d.Get("display_name").(string)
+		updateMask = append(updateMask, "displayName")
+	}
+
+	if d.HasChange("description") {
+		printerObj.Description = d.Get("description").(string)
+		updateMask = append(updateMask, "description")
+	}
+
+	if d.HasChange("uri") {
+		printerObj.Uri = d.Get("uri").(string)
+		updateMask = append(updateMask, "uri")
+	}
+
+	if d.HasChange("make_and_model") {
+		printerObj.MakeAndModel = d.Get("make_and_model").(string)
+		updateMask = append(updateMask, "makeAndModel")
+	}
+
+	if d.HasChange("use_driverless_config") {
+		printerObj.UseDriverlessConfig = d.Get("use_driverless_config").(bool)
+		printerObj.ForceSendFields = append(printerObj.ForceSendFields, "UseDriverlessConfig")
+		updateMask = append(updateMask, "useDriverlessConfig")
+	}
+
+	if len(updateMask) > 0 {
+		_, err := printersService.Patch(chromePrinterName(client.Customer, d.Id()), &printerObj).UpdateMask(strings.Join(updateMask, ",")).Do()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	log.Printf("[DEBUG] Finished updating Chrome Printer %q", d.Id())
+
+	return resourceChromePrinterRead(ctx, d, meta)
+}
+
+func resourceChromePrinterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return diags
+	}
+
+	printersService, diags := GetChromePrintersService(directoryService)
+	if diags.HasError() {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Deleting Chrome Printer %q", d.Id())
+
+	_, err := printersService.Delete(chromePrinterName(client.Customer, d.Id())).Do()
+	if err != nil {
+		return handleNotFoundError(err, d, d.Id())
+	}
+
+	log.Printf("[DEBUG] Finished deleting Chrome Printer %q", d.Id())
+
+	return diags
+}
+
+func chromePrinterName(customer, id string) string {
+	return fmt.Sprintf("customers/%s/chrome/printers/%s", customer, id)
+}
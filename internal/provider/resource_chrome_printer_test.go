@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceChromePrinter_basic(t *testing.T) {
+	t.Parallel()
+
+	ouName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceChromePrinter(ouName, "tf-test-printer", "ipp://192.168.1.10:631/ipp/print"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_chrome_printer.test", "display_name", "tf-test-printer"),
+					resource.TestCheckResourceAttr("googleworkspace_chrome_printer.test", "uri", "ipp://192.168.1.10:631/ipp/print"),
+					resource.TestCheckResourceAttr("googleworkspace_chrome_printer.test", "use_driverless_config", "true"),
+					resource.TestCheckResourceAttrSet("googleworkspace_chrome_printer.test", "create_time"),
+				),
+			},
+			{
+				ResourceName:            "googleworkspace_chrome_printer.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"org_unit_id"},
+			},
+			{
+				Config: testAccResourceChromePrinter(ouName, "tf-test-printer-updated", "ipp://192.168.1.11:631/ipp/print"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_chrome_printer.test", "display_name", "tf-test-printer-updated"),
+					resource.TestCheckResourceAttr("googleworkspace_chrome_printer.test", "uri", "ipp://192.168.1.11:631/ipp/print"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceChromePrinter(ouName, displayName, uri string) string {
+	return fmt.Sprintf(`
+resource "googleworkspace_org_unit" "test" {
+  name                 = "%s"
+  parent_org_unit_path = "/"
+}
+
+resource "googleworkspace_chrome_printer" "test" {
+  org_unit_id           = googleworkspace_org_unit.test.id
+  display_name          = "%s"
+  description           = "Terraform acceptance test printer"
+  uri                   = "%s"
+  use_driverless_config = true
+}
+`, ouName, displayName, uri)
+}
@@ -0,0 +1,475 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	directory "google.golang.org/api/admin/directory/v1"
+)
+
+// the maximum number of printers in a single batchCreatePrinters and batchDeletePrinters request
+const (
+	chromePrintersBatchCreateSize = 50
+	chromePrintersBatchDeleteSize = 100
+)
+
+func resourceChromePrinters() *schema.Resource {
+	return &schema.Resource{
+		Description: "Chrome Printers resource in the Terraform Googleworkspace provider. Manages several printers of " +
+			"an org unit, which are created and deleted with the batch endpoints of the API. Printers of the org unit " +
+			"that are not in `printers` are left untouched. Chrome Printers resides under the " +
+			"`https://www.googleapis.com/auth/admin.chrome.printers` client scope.",
+
+		CreateContext: resourceChromePrintersCreate,
+		ReadContext:   resourceChromePrintersRead,
+		UpdateContext: resourceChromePrintersUpdate,
+		DeleteContext: resourceChromePrintersDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceChromePrintersImport,
+		},
+
+		CustomizeDiff: resourceChromePrintersCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"org_unit_id": {
+				Description:      "The org unit that owns the printers.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: diffSuppressOrgUnitId,
+			},
+			"printers": {
+				Description: "The printers of the org unit. Printers are identified by their `display_name`, which " +
+					"must be unique within the resource.",
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"display_name": {
+							Description: "Name of the printer.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"description": {
+							Description: "Description of the printer.",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+						"uri": {
+							Description: "Printer URI, e.g. `ipp://192.168.1.10:631/ipp/print`.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"make_and_model": {
+							Description: "Make and model of the printer, e.g. `Lexmark MS610de`. The value must be in the " +
+								"format returned by the `googleworkspace_chrome_printer_models` data source. Not required " +
+								"when `use_driverless_config` is `true`.",
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"use_driverless_config": {
+							Description: "Whether to use the driverless (IPP Everywhere) configuration. When set, " +
+								"`make_and_model` is ignored.",
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"printer_ids": {
+				Description: "The IDs of the printers, keyed by their `display_name`.",
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceChromePrintersCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return diags
+	}
+
+	printersService, diags := GetChromePrintersService(directoryService)
+	if diags.HasError() {
+		return diags
+	}
+
+	orgUnitId := strings.TrimPrefix(d.Get("org_unit_id").(string), "id:")
+	log.Printf("[DEBUG] Creating Chrome Printers of org unit %q", orgUnitId)
+
+	printers := []*directory.Printer{}
+	for _, p := range d.Get("printers").(*schema.Set).List() {
+		printers = append(printers, expandChromePrinter(p.(map[string]interface{}), orgUnitId))
+	}
+
+	printerIds := map[string]interface{}{}
+	diags = append(diags, batchCreateChromePrinters(printersService, client.Customer, printers, printerIds)...)
+
+	// the printers created before an error are recorded, so that they are deleted with the tainted resource
+	d.SetId(orgUnitId)
+	d.Set("printer_ids", printerIds)
+	if diags.HasError() {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Finished creating Chrome Printers of org unit %q", d.Id())
+
+	return append(diags, resourceChromePrintersRead(ctx, d, meta)...)
+}
+
+func resourceChromePrintersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return diags
+	}
+
+	printersService, diags := GetChromePrintersService(directoryService)
+	if diags.HasError() {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Getting Chrome Printers of org unit %q", d.Id())
+
+	orgUnitPrinters, err := listChromePrinters(ctx, printersService, client.Customer, d.Id())
+	if err != nil {
+		return handleNotFoundError(err, d, d.Id())
+	}
+
+	managedIds := map[string]bool{}
+	for _, id := range d.Get("printer_ids").(map[string]interface{}) {
+		managedIds[id.(string)] = true
+	}
+
+	printers := []interface{}{}
+	printerIds := map[string]interface{}{}
+	for _, printer := range orgUnitPrinters {
+		if !managedIds[printer.Id] {
+			continue
+		}
+
+		printers = append(printers, flattenChromePrinter(printer))
+		printerIds[printer.DisplayName] = printer.Id
+	}
+
+	d.Set("org_unit_id", d.Id())
+	d.Set("printers", printers)
+	d.Set("printer_ids", printerIds)
+
+	log.Printf("[DEBUG] Finished getting Chrome Printers of org unit %q", d.Id())
+
+	return diags
+}
+
+func resourceChromePrintersUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return diags
+	}
+
+	printersService, diags := GetChromePrintersService(directoryService)
+	if diags.HasError() {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Updating Chrome Printers of org unit %q", d.Id())
+
+	// the planned IDs are unknown, the IDs of the printers created so far are in the state
+	oldIds, _ := d.GetChange("printer_ids")
+	printerIds := map[string]interface{}{}
+	for displayName, id := range oldIds.(map[string]interface{}) {
+		printerIds[displayName] = id
+	}
+
+	old, new := d.GetChange("printers")
+	oldPrinters := map[string]map[string]interface{}{}
+	for _, p := range old.(*schema.Set).List() {
+		oldPrinters[p.(map[string]interface{})["display_name"].(string)] = p.(map[string]interface{})
+	}
+
+	newPrinters := map[string]bool{}
+	toCreate := []*directory.Printer{}
+	for _, p := range new.(*schema.Set).List() {
+		printer := expandChromePrinter(p.(map[string]interface{}), d.Id())
+		newPrinters[printer.DisplayName] = true
+
+		id, ok := printerIds[printer.DisplayName]
+		if !ok {
+			toCreate = append(toCreate, printer)
+			continue
+		}
+
+		// printers are identified by their display name, the other settings are updated in place
+		if oldPrinter, ok := oldPrinters[printer.DisplayName]; ok && chromePrinterEqual(oldPrinter, p.(map[string]interface{})) {
+			continue
+		}
+
+		printer.ForceSendFields = []string{"Description", "MakeAndModel", "UseDriverlessConfig"}
+		_, err := printersService.Patch(chromePrinterName(client.Customer, id.(string)), printer).
+			UpdateMask("displayName,description,uri,makeAndModel,useDriverlessConfig").Do()
+		if err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	toDelete := map[string]string{}
+	for displayName, id := range printerIds {
+		if !newPrinters[displayName] {
+			toDelete[displayName] = id.(string)
+		}
+	}
+
+	diags = append(diags, batchDeleteChromePrinters(printersService, client.Customer, toDelete, printerIds, diag.Warning)...)
+	diags = append(diags, batchCreateChromePrinters(printersService, client.Customer, toCreate, printerIds)...)
+	d.Set("printer_ids", printerIds)
+	if diags.HasError() {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Finished updating Chrome Printers of org unit %q", d.Id())
+
+	return append(diags, resourceChromePrintersRead(ctx, d, meta)...)
+}
+
+func resourceChromePrintersDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return diags
+	}
+
+	printersService, diags := GetChromePrintersService(directoryService)
+	if diags.HasError() {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Deleting Chrome Printers of org unit %q", d.Id())
+
+	printerIds := d.Get("printer_ids").(map[string]interface{})
+	toDelete := map[string]string{}
+	for displayName, id := range printerIds {
+		toDelete[displayName] = id.(string)
+	}
+
+	// failures are errors, so that the destroy can be retried
+	diags = append(diags, batchDeleteChromePrinters(printersService, client.Customer, toDelete, printerIds, diag.Error)...)
+	if diags.HasError() {
+		d.Set("printer_ids", printerIds)
+		return diags
+	}
+
+	log.Printf("[DEBUG] Finished deleting Chrome Printers of org unit %q", d.Id())
+
+	return diags
+}
+
+func resourceChromePrintersImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*apiClient)
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return nil, fmt.Errorf("%s", diags[0].Summary)
+	}
+
+	printersService, diags := GetChromePrintersService(directoryService)
+	if diags.HasError() {
+		return nil, fmt.Errorf("%s", diags[0].Summary)
+	}
+
+	orgUnitId := strings.TrimPrefix(d.Id(), "id:")
+
+	// all the printers of the org unit are imported
+	printers, err := listChromePrinters(ctx, printersService, client.Customer, orgUnitId)
+	if err != nil {
+		return nil, fmt.Errorf("error importing printers of org unit %q: %s", orgUnitId, err)
+	}
+
+	printerIds := map[string]interface{}{}
+	for _, printer := range printers {
+		if _, ok := printerIds[printer.DisplayName]; ok {
+			return nil, fmt.Errorf("error importing printers of org unit %q: several printers are named %q", orgUnitId, printer.DisplayName)
+		}
+		printerIds[printer.DisplayName] = printer.Id
+	}
+
+	d.SetId(orgUnitId)
+	d.Set("printer_ids", printerIds)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceChromePrintersCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	displayNames := map[string]bool{}
+	for _, p := range diff.Get("printers").(*schema.Set).List() {
+		displayName := p.(map[string]interface{})["display_name"].(string)
+		if displayName == "" {
+			// unknown at plan time
+			continue
+		}
+
+		if displayNames[displayName] {
+			return fmt.Errorf("printers: display_name %q is used by several printers", displayName)
+		}
+		displayNames[displayName] = true
+	}
+
+	if diff.HasChange("printers") {
+		return diff.SetNewComputed("printer_ids")
+	}
+
+	return nil
+}
+
+// batchCreateChromePrinters creates the printers in batches, and records the IDs of the created printers.
+// Printers that fail to be created are reported as warnings, they are created again by the next apply
+func batchCreateChromePrinters(printersService *directory.CustomersChromePrintersService, customer string, printers []*directory.Printer, printerIds map[string]interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	parent := fmt.Sprintf("customers/%s", customer)
+	for start := 0; start < len(printers); start += chromePrintersBatchCreateSize {
+		end := start + chromePrintersBatchCreateSize
+		if end > len(printers) {
+			end = len(printers)
+		}
+
+		req := &directory.BatchCreatePrintersRequest{}
+		for _, printer := range printers[start:end] {
+			req.Requests = append(req.Requests, &directory.CreatePrinterRequest{Parent: parent, Printer: printer})
+		}
+
+		resp, err := printersService.BatchCreatePrinters(parent, req).Do()
+		if err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+
+		for _, printer := range resp.Printers {
+			printerIds[printer.DisplayName] = printer.Id
+		}
+
+		for _, failure := range resp.Failures {
+			displayName := ""
+			if failure.Printer != nil {
+				displayName = failure.Printer.DisplayName
+			}
+
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Error creating Chrome Printer %q", displayName),
+				Detail:   fmt.Sprintf("%s: %s", failure.ErrorCode, failure.ErrorMessage),
+			})
+		}
+	}
+
+	return diags
+}
+
+// batchDeleteChromePrinters deletes the printers, keyed by their display name, in batches, and removes
+// the deleted printers from the IDs. Printers that fail to be deleted are reported with the given severity
+func batchDeleteChromePrinters(printersService *directory.CustomersChromePrintersService, customer string, printers map[string]string, printerIds map[string]interface{}, severity diag.Severity) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ids := []string{}
+	displayNames := map[string]string{}
+	for displayName, id := range printers {
+		ids = append(ids, id)
+		displayNames[id] = displayName
+	}
+
+	parent := fmt.Sprintf("customers/%s", customer)
+	for start := 0; start < len(ids); start += chromePrintersBatchDeleteSize {
+		end := start + chromePrintersBatchDeleteSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+
+		resp, err := printersService.BatchDeletePrinters(parent, &directory.BatchDeletePrintersRequest{PrinterIds: ids[start:end]}).Do()
+		if err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+
+		for _, id := range resp.PrinterIds {
+			delete(printerIds, displayNames[id])
+		}
+
+		for _, failure := range resp.FailedPrinters {
+			// printers that no longer exist don't need to be deleted
+			if failure.ErrorCode == "NOT_FOUND" {
+				delete(printerIds, displayNames[failure.PrinterId])
+				continue
+			}
+
+			diags = append(diags, diag.Diagnostic{
+				Severity: severity,
+				Summary:  fmt.Sprintf("Error deleting Chrome Printer %q", displayNames[failure.PrinterId]),
+				Detail:   fmt.Sprintf("%s: %s", failure.ErrorCode, failure.ErrorMessage),
+			})
+		}
+	}
+
+	return diags
+}
+
+// listChromePrinters returns the printers owned by the org unit, without the ones inherited from its parents
+func listChromePrinters(ctx context.Context, printersService *directory.CustomersChromePrintersService, customer, orgUnitId string) ([]*directory.Printer, error) {
+	printers := []*directory.Printer{}
+
+	err := printersService.List(fmt.Sprintf("customers/%s", customer)).OrgUnitId(orgUnitId).Pages(ctx, func(resp *directory.ListPrintersResponse) error {
+		for _, printer := range resp.Printers {
+			if printer.OrgUnitId == orgUnitId {
+				printers = append(printers, printer)
+			}
+		}
+		return nil
+	})
+
+	return printers, err
+}
+
+func expandChromePrinter(p map[string]interface{}, orgUnitId string) *directory.Printer {
+	return &directory.Printer{
+		OrgUnitId:           orgUnitId,
+		DisplayName:         p["display_name"].(string),
+		Description:         p["description"].(string),
+		Uri:                 p["uri"].(string),
+		MakeAndModel:        p["make_and_model"].(string),
+		UseDriverlessConfig: p["use_driverless_config"].(bool),
+	}
+}
+
+func flattenChromePrinter(printer *directory.Printer) map[string]interface{} {
+	return map[string]interface{}{
+		"display_name":          printer.DisplayName,
+		"description":           printer.Description,
+		"uri":                   printer.Uri,
+		"make_and_model":        printer.MakeAndModel,
+		"use_driverless_config": printer.UseDriverlessConfig,
+	}
+}
+
+func chromePrinterEqual(a, b map[string]interface{}) bool {
+	for _, k := range []string{"display_name", "description", "uri", "make_and_model", "use_driverless_config"} {
+		if a[k] != b[k] {
+			return false
+		}
+	}
+
+	return true
+}
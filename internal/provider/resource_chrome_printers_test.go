@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceChromePrinters_basic(t *testing.T) {
+	t.Parallel()
+
+	ouName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceChromePrinters(ouName, "ipp://192.168.1.10:631/ipp/print", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_chrome_printers.test", "printers.#", "2"),
+					resource.TestCheckResourceAttr("googleworkspace_chrome_printers.test", "printer_ids.%", "2"),
+					resource.TestCheckResourceAttrSet("googleworkspace_chrome_printers.test", "printer_ids.tf-test-lobby"),
+				),
+			},
+			{
+				ResourceName:            "googleworkspace_chrome_printers.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"org_unit_id"},
+			},
+			{
+				// the lobby printer is updated in place and the second floor printer is deleted
+				Config: testAccResourceChromePrinters(ouName, "ipp://192.168.1.12:631/ipp/print", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_chrome_printers.test", "printers.#", "1"),
+					resource.TestCheckResourceAttr("googleworkspace_chrome_printers.test", "printer_ids.%", "1"),
+					resource.TestCheckResourceAttrSet("googleworkspace_chrome_printers.test", "printer_ids.tf-test-lobby"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceChromePrinters(ouName, lobbyUri string, secondFloor bool) string {
+	secondFloorPrinter := ""
+	if secondFloor {
+		secondFloorPrinter = `
+  printers {
+    display_name          = "tf-test-second-floor"
+    uri                   = "ipps://192.168.1.11:631/ipp/print"
+    use_driverless_config = true
+  }
+`
+	}
+
+	return fmt.Sprintf(`
+resource "googleworkspace_org_unit" "test" {
+  name                 = "%s"
+  parent_org_unit_path = "/"
+}
+
+resource "googleworkspace_chrome_printers" "test" {
+  org_unit_id = googleworkspace_org_unit.test.id
+
+  printers {
+    display_name          = "tf-test-lobby"
+    description           = "Terraform acceptance test printer"
+    uri                   = "%s"
+    use_driverless_config = true
+  }
+%s}
+`, ouName, lobbyUri, secondFloorPrinter)
+}
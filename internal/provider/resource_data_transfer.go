@@ -0,0 +1,350 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	datatransfer "google.golang.org/api/admin/datatransfer/v1"
+)
+
+func resourceDataTransfer() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data Transfer resource transfers the data of applications (e.g. Drive and Docs, Calendar) from " +
+			"one user to another, and waits for the transfer to complete. Use the " +
+			"`googleworkspace_data_transfer_applications` data source to look up the applications and their transfer " +
+			"parameters. Transfers cannot be undone, so destroying this resource only removes it from the state. " +
+			"Data Transfer resides under the `https://www.googleapis.com/auth/admin.datatransfer` client scope.",
+
+		CreateContext: resourceDataTransferCreate,
+		ReadContext:   resourceDataTransferRead,
+		DeleteContext: resourceDataTransferDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The ID of the data transfer.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"old_owner_user_id": {
+				Description: "The primary email address or unique ID of the user whose data is transferred.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"new_owner_user_id": {
+				Description: "The primary email address or unique ID of the user receiving the data.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"application_data_transfers": {
+				Description: "The applications whose data is transferred.",
+				Type:        schema.TypeList,
+				Required:    true,
+				ForceNew:    true,
+				MinItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"application_id": {
+							Description: "The ID of the application.",
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+						},
+						"application_transfer_params": {
+							Description: "The transfer parameters for the application, e.g. `PRIVACY_LEVEL` with " +
+								"the values `SHARED` and `PRIVATE` for Drive and Docs.",
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key": {
+										Description: "The type of the transfer parameter.",
+										Type:        schema.TypeString,
+										Required:    true,
+										ForceNew:    true,
+									},
+									"value": {
+										Description: "The values of the transfer parameter.",
+										Type:        schema.TypeList,
+										Required:    true,
+										ForceNew:    true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+								},
+							},
+						},
+						"application_transfer_status": {
+							Description: "Current status of the transfer for this application.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"overall_transfer_status_code": {
+				Description: "Overall transfer status.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"request_time": {
+				Description: "The time at which the data transfer was requested.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceDataTransferCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return diags
+	}
+
+	usersService, diags := GetUsersService(directoryService)
+	if diags.HasError() {
+		return diags
+	}
+
+	dataTransferService, diags := client.NewDataTransferService()
+	if diags.HasError() {
+		return diags
+	}
+
+	transfersService, diags := GetTransfersService(dataTransferService)
+	if diags.HasError() {
+		return diags
+	}
+
+	// the API only accepts unique user IDs
+	oldOwner, err := usersService.Get(d.Get("old_owner_user_id").(string)).Do()
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error retrieving old owner for data transfer: %s", err))
+	}
+
+	newOwner, err := usersService.Get(d.Get("new_owner_user_id").(string)).Do()
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error retrieving new owner for data transfer: %s", err))
+	}
+
+	applicationDataTransfers, err := expandApplicationDataTransfers(d.Get("application_data_transfers").([]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Creating Data Transfer from %q to %q", oldOwner.PrimaryEmail, newOwner.PrimaryEmail)
+
+	transfer, err := transfersService.Insert(&datatransfer.DataTransfer{
+		OldOwnerUserId:           oldOwner.Id,
+		NewOwnerUserId:           newOwner.Id,
+		ApplicationDataTransfers: applicationDataTransfers,
+	}).Do()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(transfer.Id)
+
+	err = retryTimeDuration(ctx, d.Timeout(schema.TimeoutCreate), func() error {
+		transferStatus, retryErr := transfersService.Get(d.Id()).Do()
+		if retryErr != nil && !isNotFound(retryErr) {
+			return fmt.Errorf("unexpected error during retries of data transfer: %s", retryErr)
+		}
+
+		if transferStatus != nil {
+			log.Printf("[INFO] Data transfer status is: %q", transferStatus.OverallTransferStatusCode)
+
+			switch transferStatus.OverallTransferStatusCode {
+			case "completed":
+				return nil
+			case "failed":
+				return fmt.Errorf("data transfer %s failed", d.Id())
+			}
+		}
+
+		return fmt.Errorf("timed out while waiting for data transfer to complete")
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Finished creating Data Transfer %q", d.Id())
+
+	return resourceDataTransferRead(ctx, d, meta)
+}
+
+func resourceDataTransferRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	dataTransferService, diags := client.NewDataTransferService()
+	if diags.HasError() {
+		return diags
+	}
+
+	transfersService, diags := GetTransfersService(dataTransferService)
+	if diags.HasError() {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Getting Data Transfer %q", d.Id())
+
+	transfer, err := transfersService.Get(d.Id()).Do()
+	if err != nil {
+		return handleNotFoundError(err, d, d.Id())
+	}
+
+	// keep the configured emails, unless the values were imported
+	if d.Get("old_owner_user_id").(string) == "" {
+		d.Set("old_owner_user_id", transfer.OldOwnerUserId)
+	}
+	if d.Get("new_owner_user_id").(string) == "" {
+		d.Set("new_owner_user_id", transfer.NewOwnerUserId)
+	}
+	// the configured applications are kept, only their statuses are read, unless the values were imported
+	applicationDataTransfers := flattenApplicationDataTransfers(transfer.ApplicationDataTransfers)
+	if configured := d.Get("application_data_transfers").([]interface{}); len(configured) > 0 {
+		applicationDataTransfers = withApplicationTransferStatuses(configured, transfer.ApplicationDataTransfers)
+	}
+	d.Set("application_data_transfers", applicationDataTransfers)
+	d.Set("overall_transfer_status_code", transfer.OverallTransferStatusCode)
+	d.Set("request_time", transfer.RequestTime)
+
+	log.Printf("[DEBUG] Finished getting Data Transfer %q", d.Id())
+
+	return diags
+}
+
+// Data transfers cannot be undone or deleted, the resource is only removed from state
+func resourceDataTransferDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] Removing Data Transfer %q from state, transfers cannot be deleted", d.Id())
+
+	return nil
+}
+
+// withApplicationTransferStatuses sets the transfer status of each of the configured applications
+func withApplicationTransferStatuses(configured []interface{}, applicationDataTransfers []*datatransfer.ApplicationDataTransfer) []interface{} {
+	result := make([]interface{}, len(configured))
+
+	for i, a := range configured {
+		application := map[string]interface{}{}
+		for k, v := range a.(map[string]interface{}) {
+			application[k] = v
+		}
+
+		for _, transfer := range applicationDataTransfers {
+			if strconv.FormatInt(transfer.ApplicationId, 10) == application["application_id"] {
+				application["application_transfer_status"] = transfer.ApplicationTransferStatus
+			}
+		}
+
+		result[i] = application
+	}
+
+	return result
+}
+
+// listTransferApplications returns all the applications whose data can be transferred
+func listTransferApplications(ctx context.Context, applicationsService *datatransfer.ApplicationsService) ([]*datatransfer.Application, error) {
+	var applications []*datatransfer.Application
+
+	err := applicationsService.List().Pages(ctx, func(resp *datatransfer.ApplicationsListResponse) error {
+		applications = append(applications, resp.Applications...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return applications, nil
+}
+
+// findTransferApplicationByName returns the application with the given name, or nil if there is none
+func findTransferApplicationByName(applications []*datatransfer.Application, name string) *datatransfer.Application {
+	for _, application := range applications {
+		if application.Name == name {
+			return application
+		}
+	}
+
+	return nil
+}
+
+func expandApplicationDataTransfers(v []interface{}) ([]*datatransfer.ApplicationDataTransfer, error) {
+	result := []*datatransfer.ApplicationDataTransfer{}
+
+	for _, a := range v {
+		application := a.(map[string]interface{})
+
+		applicationId, err := strconv.ParseInt(application["application_id"].(string), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid application_id %q: %s", application["application_id"], err)
+		}
+
+		params := []*datatransfer.ApplicationTransferParam{}
+		for _, p := range application["application_transfer_params"].([]interface{}) {
+			param := p.(map[string]interface{})
+
+			params = append(params, &datatransfer.ApplicationTransferParam{
+				Key:   param["key"].(string),
+				Value: listOfInterfacestoStrings(param["value"].([]interface{})),
+			})
+		}
+
+		result = append(result, &datatransfer.ApplicationDataTransfer{
+			ApplicationId:             applicationId,
+			ApplicationTransferParams: params,
+		})
+	}
+
+	return result, nil
+}
+
+func flattenApplicationDataTransfers(applicationDataTransfers []*datatransfer.ApplicationDataTransfer) []interface{} {
+	result := make([]interface{}, len(applicationDataTransfers))
+
+	for i, application := range applicationDataTransfers {
+		result[i] = map[string]interface{}{
+			"application_id":              strconv.FormatInt(application.ApplicationId, 10),
+			"application_transfer_params": flattenApplicationTransferParams(application.ApplicationTransferParams),
+			"application_transfer_status": application.ApplicationTransferStatus,
+		}
+	}
+
+	return result
+}
+
+func flattenApplicationTransferParams(params []*datatransfer.ApplicationTransferParam) []interface{} {
+	result := make([]interface{}, len(params))
+
+	for i, param := range params {
+		result[i] = map[string]interface{}{
+			"key":   param.Key,
+			"value": param.Value,
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	datatransfer "google.golang.org/api/admin/datatransfer/v1"
+)
+
+func TestAccResourceDataTransfer_basic(t *testing.T) {
+	t.Parallel()
+
+	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+
+	if domainName == "" {
+		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
+	}
+
+	testUserVals := map[string]interface{}{
+		"domainName": domainName,
+		"userEmail":  fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+		"password":   acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDataTransfer(testUserVals),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_data_transfer.test", "overall_transfer_status_code", "completed"),
+					resource.TestCheckResourceAttrSet("googleworkspace_data_transfer.test", "request_time"),
+				),
+			},
+			{
+				ResourceName:            "googleworkspace_data_transfer.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"old_owner_user_id", "new_owner_user_id"},
+			},
+		},
+	})
+}
+
+func TestResourceDataTransfer_withApplicationTransferStatuses(t *testing.T) {
+	configured := []interface{}{
+		map[string]interface{}{
+			"application_id": "435070579839",
+			"application_transfer_params": []interface{}{
+				map[string]interface{}{"key": "RELEASE_RESOURCES", "value": []interface{}{"TRUE"}},
+			},
+			"application_transfer_status": "",
+		},
+	}
+
+	// the API may return the parameters differently, only the status is read
+	result := withApplicationTransferStatuses(configured, []*datatransfer.ApplicationDataTransfer{
+		{
+			ApplicationId:             435070579839,
+			ApplicationTransferStatus: "completed",
+		},
+	})
+
+	application := result[0].(map[string]interface{})
+	if application["application_transfer_status"] != "completed" {
+		t.Errorf("expected the transfer status to be read, got %q", application["application_transfer_status"])
+	}
+
+	if len(application["application_transfer_params"].([]interface{})) != 1 {
+		t.Errorf("expected the configured transfer params to be kept, got %v", application["application_transfer_params"])
+	}
+
+	if configured[0].(map[string]interface{})["application_transfer_status"] != "" {
+		t.Errorf("expected the configured value not to be modified")
+	}
+}
+
+func testAccResourceDataTransfer(testUserVals map[string]interface{}) string {
+	return Nprintf(`
+data "googleworkspace_data_transfer_applications" "all" {}
+
+locals {
+  calendar = [for app in data.googleworkspace_data_transfer_applications.all.applications : app if app.name == "Calendar"][0]
+}
+
+resource "googleworkspace_user" "old" {
+  primary_email = "%{userEmail}-old@%{domainName}"
+  password      = "%{password}"
+
+  name {
+    family_name = "Scott"
+    given_name  = "Michael"
+  }
+}
+
+resource "googleworkspace_user" "new" {
+  primary_email = "%{userEmail}-new@%{domainName}"
+  password      = "%{password}"
+
+  name {
+    family_name = "Halpert"
+    given_name  = "Jim"
+  }
+}
+
+resource "googleworkspace_data_transfer" "test" {
+  old_owner_user_id = googleworkspace_user.old.primary_email
+  new_owner_user_id = googleworkspace_user.new.primary_email
+
+  application_data_transfers {
+    application_id = local.calendar.id
+
+    application_transfer_params {
+      key   = "RELEASE_RESOURCES"
+      value = ["TRUE"]
+    }
+  }
+}
+`, testUserVals)
+}
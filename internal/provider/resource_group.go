@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -26,6 +27,7 @@ func resourceGroup() *schema.Resource {
 		CreateContext: resourceGroupCreate,
 		ReadContext:   resourceGroupRead,
 		UpdateContext: resourceGroupUpdate,
+		CustomizeDiff: resourceGroupCustomizeDiff,
 		DeleteContext: resourceGroupDelete,
 
 		Timeouts: &schema.ResourceTimeout{
@@ -34,7 +36,7 @@ func resourceGroup() *schema.Resource {
 		},
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceGroupImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -80,6 +82,22 @@ func resourceGroup() *schema.Resource {
 				Type:        schema.TypeString,
 				Computed:    true,
 			},
+			"keep_old_email_as_alias": {
+				Description: "If true, when `email` changes, the old email is kept as an alias of the group, and " +
+					"recorded in `previous_email_aliases` instead of `aliases`. Otherwise it is deleted, unless it " +
+					"is one of the configured `aliases`.",
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"previous_email_aliases": {
+				Description: "The previous emails of the group kept as aliases because of `keep_old_email_as_alias`. " +
+					"They are not included in `aliases`, unless configured there.",
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 			"aliases": {
 				Description: "asps.list of group's email addresses.",
 				Type:        schema.TypeList,
@@ -89,6 +107,14 @@ func resourceGroup() *schema.Resource {
 				},
 				DiffSuppressFunc: diffSuppressAliases,
 			},
+			"adopt_existing": {
+				Description: "If true, and a group with the same `email` already exists (e.g. created in the " +
+					"Admin Console or by GCDS), the existing group is adopted into the state and updated to match the " +
+					"configuration, instead of the create failing. The fields of the existing group that are overwritten are " +
+					"reported in a warning. Adoption fails if `email` is an alias of another group.",
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			"non_editable_aliases": {
 				Description: "asps.list of the group's non-editable alias email addresses that are outside of the " +
 					"account's primary domain or subdomains. These are functioning email addresses used by the group.",
@@ -127,7 +153,24 @@ func resourceGroupCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		Description: d.Get("description").(string),
 	}
 
-	group, err := groupsService.Insert(&groupObj).Do()
+	var existingGroup *directory.Group
+	if d.Get("adopt_existing").(bool) {
+		var err error
+		existingGroup, err = findExistingGroup(groupsService, email)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	var group *directory.Group
+	var err error
+	if existingGroup != nil {
+		log.Printf("[DEBUG] Adopting existing Group %q: %#v", existingGroup.Id, email)
+		diags = adoptionConflictsWarning("group", email, groupAdoptionConflicts(existingGroup, &groupObj))
+		group, err = groupsService.Update(existingGroup.Id, &groupObj).Do()
+	} else {
+		group, err = groupsService.Insert(&groupObj).Do()
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -151,6 +194,11 @@ func resourceGroupCreate(ctx context.Context, d *schema.ResourceData, meta inter
 				Alias: d.Get(fmt.Sprintf("aliases.%d", i)).(string),
 			}
 
+			// adopted groups may already have some of the aliases
+			if existingGroup != nil && stringInSlice(existingGroup.Aliases, aliasObj.Alias) {
+				continue
+			}
+
 			_, err := aliasesService.Insert(d.Id(), &aliasObj).Do()
 			if err != nil {
 				return diag.FromErr(err)
@@ -194,7 +242,7 @@ func resourceGroupCreate(ctx context.Context, d *schema.ResourceData, meta inter
 
 	log.Printf("[DEBUG] Finished creating Group %q: %#v", d.Id(), email)
 
-	return resourceGroupRead(ctx, d, meta)
+	return append(diags, resourceGroupRead(ctx, d, meta)...)
 }
 
 func resourceGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -223,7 +271,15 @@ func resourceGroupRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	d.Set("description", group.Description)
 	d.Set("admin_created", group.AdminCreated)
 	d.Set("direct_members_count", group.DirectMembersCount)
-	d.Set("aliases", group.Aliases)
+	// previous emails kept as aliases are not included in aliases, the data sources don't have them
+	previousEmails, ok := d.Get("previous_email_aliases").([]interface{})
+	aliases, keptEmails := withoutPreviousEmails(group.Aliases, listOfInterfacestoStrings(previousEmails))
+	if ok {
+		d.Set("previous_email_aliases", keptEmails)
+	} else {
+		aliases = group.Aliases
+	}
+	d.Set("aliases", aliases)
 	d.Set("non_editable_aliases", group.NonEditableAliases)
 	d.Set("etag", group.Etag)
 
@@ -254,7 +310,10 @@ func resourceGroupUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 	groupObj := directory.Group{}
 
 	if d.HasChange("email") {
-		groupObj.Email = d.Get("email").(string)
+		diags = renameGroup(ctx, d, groupsService)
+		if diags.HasError() {
+			return diags
+		}
 	}
 
 	if d.HasChange("name") {
@@ -342,6 +401,16 @@ func resourceGroupUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 		return fmt.Errorf("timed out while waiting for %s to be updated", cc.resourceType)
 	})
 
+	// previous emails that are now configured as aliases are managed in aliases instead. The planned
+	// previous emails are unknown, they are in the state, unless the rename above has already set them
+	previous, _ := d.GetChange("previous_email_aliases")
+	if d.HasChange("email") {
+		previous = d.Get("previous_email_aliases")
+	}
+	previousEmails, _ := withoutPreviousEmails(listOfInterfacestoStrings(previous.([]interface{})),
+		listOfInterfacestoStrings(d.Get("aliases").([]interface{})))
+	d.Set("previous_email_aliases", previousEmails)
+
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -370,6 +439,10 @@ func resourceGroupDelete(ctx context.Context, d *schema.ResourceData, meta inter
 		return diags
 	}
 
+	if err := client.deletionGuard.reserveDeletion("group", email); err != nil {
+		return diag.FromErr(err)
+	}
+
 	err := groupsService.Delete(d.Id()).Do()
 	if err != nil {
 		return handleNotFoundError(err, d, d.Get("email").(string))
@@ -379,3 +452,112 @@ func resourceGroupDelete(ctx context.Context, d *schema.ResourceData, meta inter
 
 	return diags
 }
+
+func resourceGroupCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	// renames may keep the old email as an alias, and configured aliases are no longer previous emails
+	if diff.Id() != "" && (diff.HasChange("email") || diff.HasChange("aliases")) {
+		return diff.SetNewComputed("previous_email_aliases")
+	}
+
+	return nil
+}
+
+// renameGroup changes the email of an existing group, and waits until the rename is consistent.
+// The old email is either deleted, or kept as an alias
+func renameGroup(ctx context.Context, d *schema.ResourceData, groupsService *directory.GroupsService) diag.Diagnostics {
+	old, new := d.GetChange("email")
+
+	rename := &emailRename{
+		resourceType: "group",
+		id:           d.Id(),
+		oldEmail:     old.(string),
+		newEmail:     new.(string),
+		rename: func() error {
+			_, err := groupsService.Update(d.Id(), &directory.Group{Email: new.(string)}).Do()
+			return err
+		},
+		resolve: func(email string) (string, string, error) {
+			group, err := groupsService.Get(email).Fields("id,email").Do()
+			if err != nil {
+				return "", "", err
+			}
+			return group.Id, group.Email, nil
+		},
+		deleteAlias: func(alias string) error {
+			return groupsService.Aliases.Delete(d.Id(), alias).Do()
+		},
+	}
+
+	kept, err := rename.apply(ctx, d.Timeout(schema.TimeoutUpdate), d.Get("keep_old_email_as_alias").(bool),
+		listOfInterfacestoStrings(d.Get("aliases").([]interface{})))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// the planned previous emails are unknown, the ones kept by earlier renames are in the state
+	previous, _ := d.GetChange("previous_email_aliases")
+	previousEmails := listOfInterfacestoStrings(previous.([]interface{}))
+	if kept {
+		previousEmails = append(previousEmails, old.(string))
+	}
+	d.Set("previous_email_aliases", previousEmails)
+
+	return nil
+}
+
+// groupAdoptionConflicts returns the fields of the existing group that are overwritten by the configuration
+func groupAdoptionConflicts(existing, groupObj *directory.Group) []string {
+	conflicts := []string{}
+
+	if groupObj.Name != "" && groupObj.Name != existing.Name {
+		conflicts = append(conflicts, "name")
+	}
+
+	if groupObj.Description != existing.Description {
+		conflicts = append(conflicts, "description")
+	}
+
+	return conflicts
+}
+
+// findExistingGroup returns the group with the given email, or nil if it does not exist
+func findExistingGroup(groupsService *directory.GroupsService, email string) (*directory.Group, error) {
+	group, err := groupsService.Get(email).Do()
+	if isNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// groups can also be retrieved by their aliases, which must not be adopted
+	if !strings.EqualFold(group.Email, email) {
+		return nil, fmt.Errorf("cannot adopt group %s, it is an alias of group %s", email, group.Email)
+	}
+
+	return group, nil
+}
+
+// resourceGroupImport resolves the group's email or one of its aliases to the immutable group ID
+func resourceGroupImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*apiClient)
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return nil, fmt.Errorf("%s", diags[0].Summary)
+	}
+
+	groupsService, diags := GetGroupsService(directoryService)
+	if diags.HasError() {
+		return nil, fmt.Errorf("%s", diags[0].Summary)
+	}
+
+	group, err := groupsService.Get(d.Id()).Fields("id").Do()
+	if err != nil {
+		return nil, fmt.Errorf("error importing group %q: %s", d.Id(), err)
+	}
+
+	d.SetId(group.Id)
+
+	return []*schema.ResourceData{d}, nil
+}
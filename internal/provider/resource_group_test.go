@@ -77,6 +77,61 @@ func checkGroupImportState() resource.ImportStateCheckFunc {
 	)
 }
 
+func TestAccResourceGroup_rename(t *testing.T) {
+	t.Parallel()
+
+	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+
+	if domainName == "" {
+		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
+	}
+
+	testGroupVals := map[string]interface{}{
+		"domainName": domainName,
+		"email":      fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+	}
+
+	oldEmail := fmt.Sprintf("%s@%s", testGroupVals["email"], domainName)
+	renamedEmail := fmt.Sprintf("%s-renamed@%s", testGroupVals["email"], domainName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceGroup_rename(testGroupVals, "", "false"),
+			},
+			{
+				// the old email is kept as an alias, without a diff on aliases
+				Config: testAccResourceGroup_rename(testGroupVals, "-renamed", "true"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_group.my-group", "previous_email_aliases.#", "1"),
+					resource.TestCheckResourceAttr("googleworkspace_group.my-group", "previous_email_aliases.0", oldEmail),
+					resource.TestCheckResourceAttr("googleworkspace_group.my-group", "aliases.#", "0"),
+				),
+			},
+			{
+				// the emails kept by earlier renames stay in previous_email_aliases
+				Config: testAccResourceGroup_rename(testGroupVals, "-renamed-again", "true"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_group.my-group", "previous_email_aliases.#", "2"),
+					resource.TestCheckResourceAttr("googleworkspace_group.my-group", "previous_email_aliases.0", oldEmail),
+					resource.TestCheckResourceAttr("googleworkspace_group.my-group", "previous_email_aliases.1", renamedEmail),
+					resource.TestCheckResourceAttr("googleworkspace_group.my-group", "aliases.#", "0"),
+				),
+			},
+			{
+				// the old email is deleted
+				Config: testAccResourceGroup_rename(testGroupVals, "-renamed-once-more", "false"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_group.my-group", "previous_email_aliases.#", "2"),
+					resource.TestCheckResourceAttr("googleworkspace_group.my-group", "aliases.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccResourceGroup_full(t *testing.T) {
 	t.Parallel()
 
@@ -104,6 +159,24 @@ func TestAccResourceGroup_full(t *testing.T) {
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: []string{"etag"},
 			},
+			{
+				// TestStep imports by `email`
+				ResourceName:            "googleworkspace_group.my-group",
+				ImportState:             true,
+				ImportStateId:           fmt.Sprintf("%s@%s", testGroupVals["email"], domainName),
+				ImportStateCheck:        checkGroupImportState(),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"etag"},
+			},
+			{
+				// TestStep imports by one of the aliases of the group
+				ResourceName:            "googleworkspace_group.my-group",
+				ImportState:             true,
+				ImportStateId:           fmt.Sprintf("%s-alias-1@%s", testGroupVals["email"], domainName),
+				ImportStateCheck:        checkGroupImportState(),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"etag"},
+			},
 			{
 				Config: testAccResourceGroup_fullUpdate(testGroupVals),
 			},
@@ -148,3 +221,16 @@ resource "googleworkspace_group" "my-group" {
 }
 `, testGroupVals)
 }
+
+func testAccResourceGroup_rename(testGroupVals map[string]interface{}, suffix, keepOldEmail string) string {
+	testGroupVals["suffix"] = suffix
+	testGroupVals["keepOldEmail"] = keepOldEmail
+
+	return Nprintf(`
+resource "googleworkspace_group" "my-group" {
+  email = "%{email}%{suffix}@%{domainName}"
+
+  keep_old_email_as_alias = %{keepOldEmail}
+}
+`, testGroupVals)
+}
@@ -11,6 +11,7 @@ import (
 	directory "google.golang.org/api/admin/directory/v1"
 	"google.golang.org/api/googleapi"
 	"log"
+	"strings"
 )
 
 func resourceOrgUnit() *schema.Resource {
@@ -25,7 +26,7 @@ func resourceOrgUnit() *schema.Resource {
 		DeleteContext: resourceOrgUnitDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceOrgUnitImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -87,6 +88,14 @@ func resourceOrgUnit() *schema.Resource {
 				Computed:     true,
 				ExactlyOneOf: []string{"parent_org_unit_id", "parent_org_unit_path"},
 			},
+			"adopt_existing": {
+				Description: "If true, and an organizational unit with the same `name` already exists under the parent " +
+					"(e.g. created in the Admin Console or by GCDS), the existing organizational unit is adopted into the " +
+					"state and updated to match the configuration, instead of the create failing. The fields of the existing " +
+					"organizational unit that are overwritten are reported in a warning.",
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			// Adding a computed id simply to override the `optional` id that gets added in the SDK
 			// that will then display improperly in the docs
 			"id": {
@@ -129,8 +138,25 @@ func resourceOrgUnitCreate(ctx context.Context, d *schema.ResourceData, meta int
 		orgUnitObj.ParentOrgUnitPath = d.Get("parent_org_unit_path").(string)
 	}
 
+	var existingOrgUnit *directory.OrgUnit
+	if d.Get("adopt_existing").(bool) {
+		var err error
+		existingOrgUnit, err = findExistingOrgUnit(orgUnitsService, client.Customer, &orgUnitObj)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	var orgUnit *directory.OrgUnit
-	orgUnit, err := orgUnitsService.Insert(client.Customer, &orgUnitObj).Do()
+	var err error
+	if existingOrgUnit != nil {
+		log.Printf("[DEBUG] Adopting existing OrgUnit %q: %#v", existingOrgUnit.OrgUnitId, ouName)
+		diags = adoptionConflictsWarning("org unit", ouName, orgUnitAdoptionConflicts(existingOrgUnit, &orgUnitObj))
+		orgUnitObj.ForceSendFields = []string{"Description", "BlockInheritance"}
+		orgUnit, err = orgUnitsService.Update(client.Customer, existingOrgUnit.OrgUnitId, &orgUnitObj).Do()
+	} else {
+		orgUnit, err = orgUnitsService.Insert(client.Customer, &orgUnitObj).Do()
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -169,7 +195,7 @@ func resourceOrgUnitCreate(ctx context.Context, d *schema.ResourceData, meta int
 
 	log.Printf("[DEBUG] Finished creating OrgUnit %q: %#v", d.Id(), ouName)
 
-	return resourceOrgUnitRead(ctx, d, meta)
+	return append(diags, resourceOrgUnitRead(ctx, d, meta)...)
 }
 
 func resourceOrgUnitRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -318,3 +344,68 @@ func resourceOrgUnitDelete(ctx context.Context, d *schema.ResourceData, meta int
 
 	return diags
 }
+
+// orgUnitAdoptionConflicts returns the fields of the existing org unit that are overwritten by the configuration
+func orgUnitAdoptionConflicts(existing, orgUnitObj *directory.OrgUnit) []string {
+	conflicts := []string{}
+
+	if orgUnitObj.Description != existing.Description {
+		conflicts = append(conflicts, "description")
+	}
+
+	if orgUnitObj.BlockInheritance != existing.BlockInheritance {
+		conflicts = append(conflicts, "block_inheritance")
+	}
+
+	return conflicts
+}
+
+// findExistingOrgUnit returns the org unit with the name and parent of orgUnitObj, or nil if it does not exist
+func findExistingOrgUnit(orgUnitsService *directory.OrgunitsService, customer string, orgUnitObj *directory.OrgUnit) (*directory.OrgUnit, error) {
+	parentPath := orgUnitObj.ParentOrgUnitPath
+	if orgUnitObj.ParentOrgUnitId != "" {
+		parent, err := orgUnitsService.Get(customer, "id:"+strings.TrimPrefix(orgUnitObj.ParentOrgUnitId, "id:")).Do()
+		if err != nil {
+			return nil, err
+		}
+		parentPath = parent.OrgUnitPath
+	}
+
+	// org units are retrieved by their full path, without the leading slash
+	path := strings.TrimPrefix(strings.TrimSuffix(parentPath, "/")+"/"+orgUnitObj.Name, "/")
+
+	orgUnit, err := orgUnitsService.Get(customer, path).Do()
+	if isNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return orgUnit, nil
+}
+
+// resourceOrgUnitImport resolves the org unit's full path, e.g. `/Sales/East`, to the immutable org unit ID
+func resourceOrgUnitImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*apiClient)
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return nil, fmt.Errorf("%s", diags[0].Summary)
+	}
+
+	orgUnitsService, diags := GetOrgUnitsService(directoryService)
+	if diags.HasError() {
+		return nil, fmt.Errorf("%s", diags[0].Summary)
+	}
+
+	// the API expects paths without the leading slash
+	orgUnit, err := orgUnitsService.Get(client.Customer, strings.TrimPrefix(d.Id(), "/")).Fields("orgUnitId").Do()
+	if err != nil {
+		return nil, fmt.Errorf("error importing org unit %q: %s", d.Id(), err)
+	}
+
+	d.SetId(orgUnit.OrgUnitId)
+
+	return []*schema.ResourceData{d}, nil
+}
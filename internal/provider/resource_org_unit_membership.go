@@ -0,0 +1,458 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	directory "google.golang.org/api/admin/directory/v1"
+)
+
+func resourceOrgUnitMembership() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Org Unit Membership resource manages which users are placed in a Google Workspace Org Unit, " +
+			"separately from the users themselves. The users are given either as a set of emails in `users`, or as a " +
+			"Directory `query`. Users are moved into the org unit, and users that are no longer members are moved to " +
+			"`removal_org_unit_path`. Each user is moved with its own API request, requests are not batched, and up to " +
+			"`concurrency` requests are sent concurrently. A failure to move one user is " +
+			"reported as a warning for that user without stopping the others, and the user is moved on the next " +
+			"apply as one of the `pending_emails`. Users managed by this resource " +
+			"should not have `org_unit_path` set in `googleworkspace_user`, e.g. by using `lifecycle` `ignore_changes`. " +
+			"Org Unit Membership resides under the `https://www.googleapis.com/auth/admin.directory.user` and " +
+			"`https://www.googleapis.com/auth/admin.directory.orgunit` client scopes.",
+
+		CreateContext: resourceOrgUnitMembershipCreate,
+		ReadContext:   resourceOrgUnitMembershipRead,
+		UpdateContext: resourceOrgUnitMembershipUpdate,
+		DeleteContext: resourceOrgUnitMembershipDelete,
+
+		CustomizeDiff: resourceOrgUnitMembershipCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"org_unit_path": {
+				Description: "The full path of the org unit the users are placed in. When it changes, the members " +
+					"are moved directly from the previous org unit to the new one.",
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"users": {
+				Description:  "The primary emails of the users placed in the org unit. Conflicts with `query`.",
+				Type:         schema.TypeSet,
+				Optional:     true,
+				ExactlyOneOf: []string{"users", "query"},
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"query": {
+				Description: "A Directory query matching the users placed in the org unit, e.g. `orgDepartment='Sales'`. " +
+					"The query is evaluated on every plan. See [Search for users](https://developers.google.com/admin-sdk/directory/v1/guides/search-users) " +
+					"for the syntax. Conflicts with `users`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"users", "query"},
+			},
+			"authoritative": {
+				Description: "If true, users that are in the org unit but are not members are also moved to " +
+					"`removal_org_unit_path`. Otherwise only users that were previously members are moved out.",
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"removal_org_unit_path": {
+				Description: "The full path of the org unit users are moved to when they are no longer members, " +
+					"including when this resource is destroyed.",
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "/",
+			},
+			"concurrency": {
+				Description:      "The maximum number of API requests sent concurrently. Each user is moved with its own request.",
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          50,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(1, 500)),
+			},
+			"max_moves": {
+				Description: "The maximum number of users that may be moved in a single apply, to guard against " +
+					"mass moves (e.g. by a query that matches too many users). When more users would be moved, " +
+					"including when this resource is destroyed, the apply fails without moving any user. `0` means no limit.",
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          0,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+			},
+			"org_unit_id": {
+				Description: "The unique ID of the org unit.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"member_emails": {
+				Description: "The primary emails of the members that are in the org unit.",
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"pending_emails": {
+				Description: "The primary emails of the users that need to be moved into or out of the org unit. " +
+					"These users are moved on the next apply.",
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			// Adding a computed id simply to override the `optional` id that gets added in the SDK
+			// that will then display improperly in the docs
+			"id": {
+				Description: "The ID of this resource.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceOrgUnitMembershipCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	orgUnitPath := d.Get("org_unit_path").(string)
+	log.Printf("[DEBUG] Creating Org Unit Membership for %q", orgUnitPath)
+
+	diags := setOrgUnitMembershipOrgUnit(d, client)
+	if diags.HasError() {
+		return diags
+	}
+
+	// failures to move single users are warnings, so an error means that no user was moved
+	diags = reconcileOrgUnitMembership(ctx, d, meta)
+	if diags.HasError() {
+		d.SetId("")
+		return diags
+	}
+
+	log.Printf("[DEBUG] Finished creating Org Unit Membership %q", d.Id())
+
+	return append(diags, resourceOrgUnitMembershipRead(ctx, d, meta)...)
+}
+
+func resourceOrgUnitMembershipRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	orgUnitPath := d.Get("org_unit_path").(string)
+	log.Printf("[DEBUG] Getting Org Unit Membership %q", d.Id())
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return diags
+	}
+
+	usersService, diags := GetUsersService(directoryService)
+	if diags.HasError() {
+		return diags
+	}
+
+	desired, err := getDesiredOrgUnitMembers(ctx, d, usersService, client.Customer)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	current, err := listOrgUnitUsers(ctx, usersService, client.Customer, orgUnitPath)
+	if err != nil {
+		return handleNotFoundError(err, d, orgUnitPath)
+	}
+
+	members := []string{}
+	pending := []string{}
+	for email := range desired {
+		if _, ok := current[email]; ok {
+			members = append(members, email)
+		} else {
+			pending = append(pending, email)
+		}
+	}
+
+	if d.Get("authoritative").(bool) {
+		for email := range current {
+			if !desired[email] {
+				pending = append(pending, email)
+			}
+		}
+	}
+
+	sort.Strings(pending)
+
+	d.Set("member_emails", members)
+	d.Set("pending_emails", pending)
+
+	log.Printf("[DEBUG] Finished getting Org Unit Membership %q: %d members, %d pending", d.Id(), len(members), len(pending))
+
+	return nil
+}
+
+func resourceOrgUnitMembershipUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	log.Printf("[DEBUG] Updating Org Unit Membership %q", d.Id())
+
+	if d.HasChange("org_unit_path") {
+		diags := setOrgUnitMembershipOrgUnit(d, client)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	diags := reconcileOrgUnitMembership(ctx, d, meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Finished updating Org Unit Membership %q", d.Id())
+
+	return append(diags, resourceOrgUnitMembershipRead(ctx, d, meta)...)
+}
+
+func resourceOrgUnitMembershipDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	orgUnitPath := d.Get("org_unit_path").(string)
+	removalOrgUnitPath := d.Get("removal_org_unit_path").(string)
+	log.Printf("[DEBUG] Deleting Org Unit Membership %q, moving members to %s", d.Id(), removalOrgUnitPath)
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return diags
+	}
+
+	usersService, diags := GetUsersService(directoryService)
+	if diags.HasError() {
+		return diags
+	}
+
+	current, err := listOrgUnitUsers(ctx, usersService, client.Customer, orgUnitPath)
+	if err != nil {
+		return handleNotFoundError(err, d, orgUnitPath)
+	}
+
+	var ops []func() bulkUserResult
+	for _, raw := range d.Get("member_emails").(*schema.Set).List() {
+		email := raw.(string)
+		if user, ok := current[email]; ok {
+			ops = append(ops, moveOrgUnitMemberOp(usersService, email, user.Id, removalOrgUnitPath))
+		}
+	}
+
+	if maxMoves := d.Get("max_moves").(int); maxMoves > 0 && len(ops) > maxMoves {
+		return diag.Errorf("%d users would be moved out of %s, which is more than max_moves (%d). "+
+			"No users were moved.", len(ops), orgUnitPath, maxMoves)
+	}
+
+	// failures keep the resource in the state, so that destroying it is retried
+	for _, res := range runBulkUserOps(ctx, ops, d.Get("concurrency").(int)) {
+		if res.err != nil && !isNotFound(res.err) {
+			diags = append(diags, bulkUserDiagnostic(res, diag.Error))
+		}
+	}
+
+	log.Printf("[DEBUG] Finished deleting Org Unit Membership %q", d.Id())
+
+	return diags
+}
+
+func resourceOrgUnitMembershipCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.Id() != "" && diff.HasChange("org_unit_path") {
+		if err := diff.SetNewComputed("org_unit_id"); err != nil {
+			return err
+		}
+	}
+
+	// users found out of place while refreshing are planned away, so they are moved on apply
+	if diff.Id() != "" && len(diff.Get("pending_emails").([]interface{})) > 0 {
+		return diff.SetNew("pending_emails", []interface{}{})
+	}
+
+	return nil
+}
+
+// setOrgUnitMembershipOrgUnit looks up the org unit at org_unit_path, and sets the ID and org_unit_id from it
+func setOrgUnitMembershipOrgUnit(d *schema.ResourceData, client *apiClient) diag.Diagnostics {
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return diags
+	}
+
+	orgUnitsService, diags := GetOrgUnitsService(directoryService)
+	if diags.HasError() {
+		return diags
+	}
+
+	orgUnit, err := orgUnitsService.Get(client.Customer, strings.TrimLeft(d.Get("org_unit_path").(string), "/")).Do()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("orgunits/%s/memberships", strings.TrimPrefix(orgUnit.OrgUnitId, "id:")))
+	d.Set("org_unit_id", orgUnit.OrgUnitId)
+
+	return nil
+}
+
+// reconcileOrgUnitMembership moves the desired users into the org unit, and the users that are no longer
+// members out of it. Failures to move single users are reported as warnings, so that the resource is not
+// tainted, and the users are moved again on the next apply.
+func reconcileOrgUnitMembership(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	orgUnitPath := d.Get("org_unit_path").(string)
+	removalOrgUnitPath := d.Get("removal_org_unit_path").(string)
+
+	if strings.EqualFold(strings.TrimRight(orgUnitPath, "/"), strings.TrimRight(removalOrgUnitPath, "/")) {
+		return diag.Errorf("removal_org_unit_path must be different from org_unit_path (%s)", orgUnitPath)
+	}
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return diags
+	}
+
+	usersService, diags := GetUsersService(directoryService)
+	if diags.HasError() {
+		return diags
+	}
+
+	desired, err := getDesiredOrgUnitMembers(ctx, d, usersService, client.Customer)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	current, err := listOrgUnitUsers(ctx, usersService, client.Customer, orgUnitPath)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var ops []func() bulkUserResult
+
+	desiredEmails := make([]string, 0, len(desired))
+	for email := range desired {
+		desiredEmails = append(desiredEmails, email)
+	}
+	sort.Strings(desiredEmails)
+
+	for _, email := range desiredEmails {
+		if _, ok := current[email]; !ok {
+			ops = append(ops, moveOrgUnitMemberOp(usersService, email, email, orgUnitPath))
+		}
+	}
+
+	// previous members, or any user in the org unit when authoritative, are moved out
+	for email, user := range current {
+		if desired[email] {
+			continue
+		}
+
+		if d.Get("authoritative").(bool) || d.Get("member_emails").(*schema.Set).Contains(email) {
+			ops = append(ops, moveOrgUnitMemberOp(usersService, email, user.Id, removalOrgUnitPath))
+		}
+	}
+
+	// when the org unit changes, the desired members are moved directly to the new org unit above,
+	// and the previous members that are no longer desired are moved out of the previous org unit
+	if d.HasChange("org_unit_path") && !d.IsNewResource() {
+		oldOrgUnitPath, _ := d.GetChange("org_unit_path")
+
+		previous, err := listOrgUnitUsers(ctx, usersService, client.Customer, oldOrgUnitPath.(string))
+		if err != nil && !isNotFound(err) {
+			return diag.FromErr(err)
+		}
+
+		for email, user := range previous {
+			if !desired[email] && d.Get("member_emails").(*schema.Set).Contains(email) {
+				ops = append(ops, moveOrgUnitMemberOp(usersService, email, user.Id, removalOrgUnitPath))
+			}
+		}
+	}
+
+	if maxMoves := d.Get("max_moves").(int); maxMoves > 0 && len(ops) > maxMoves {
+		return diag.Errorf("%d users would be moved into or out of %s, which is more than max_moves (%d). "+
+			"No users were moved.", len(ops), orgUnitPath, maxMoves)
+	}
+
+	log.Printf("[DEBUG] Moving %d users into or out of %s", len(ops), orgUnitPath)
+
+	for _, res := range runBulkUserOps(ctx, ops, d.Get("concurrency").(int)) {
+		if res.err != nil {
+			diags = append(diags, bulkUserDiagnostic(res, diag.Warning))
+		}
+	}
+
+	return diags
+}
+
+func moveOrgUnitMemberOp(usersService *directory.UsersService, email, userKey, orgUnitPath string) func() bulkUserResult {
+	return func() bulkUserResult {
+		res := bulkUserResult{key: email, userId: userKey, action: fmt.Sprintf("moving to %s", orgUnitPath)}
+
+		_, err := usersService.Patch(userKey, &directory.User{OrgUnitPath: orgUnitPath}).Do()
+		res.err = err
+
+		return res
+	}
+}
+
+// getDesiredOrgUnitMembers returns the lowercased primary emails of the desired members,
+// either from the users set or by running the query
+func getDesiredOrgUnitMembers(ctx context.Context, d *schema.ResourceData, usersService *directory.UsersService, customer string) (map[string]bool, error) {
+	result := map[string]bool{}
+
+	query := d.Get("query").(string)
+	if query == "" {
+		for _, email := range d.Get("users").(*schema.Set).List() {
+			result[strings.ToLower(email.(string))] = true
+		}
+
+		return result, nil
+	}
+
+	err := usersService.List().Customer(customer).Query(query).MaxResults(500).Pages(ctx, func(resp *directory.Users) error {
+		for _, user := range resp.Users {
+			result[strings.ToLower(user.PrimaryEmail)] = true
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error running query %q: %s", query, err)
+	}
+
+	return result, nil
+}
+
+// listOrgUnitUsers returns the users placed directly in the org unit, keyed by lowercased primary email
+func listOrgUnitUsers(ctx context.Context, usersService *directory.UsersService, customer, orgUnitPath string) (map[string]*directory.User, error) {
+	result := map[string]*directory.User{}
+
+	// the query also matches the users of child org units, which are filtered out
+	query := fmt.Sprintf("orgUnitPath='%s'", strings.ReplaceAll(orgUnitPath, "'", "\\'"))
+	err := usersService.List().Customer(customer).Query(query).MaxResults(500).Pages(ctx, func(resp *directory.Users) error {
+		for _, user := range resp.Users {
+			if strings.EqualFold(user.OrgUnitPath, orgUnitPath) {
+				result[strings.ToLower(user.PrimaryEmail)] = user
+			}
+		}
+
+		return nil
+	})
+
+	return result, err
+}
@@ -0,0 +1,161 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceOrgUnitMembership_basic(t *testing.T) {
+	t.Parallel()
+
+	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+
+	if domainName == "" {
+		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
+	}
+
+	testMembershipVals := map[string]interface{}{
+		"domainName": domainName,
+		"ouName":     fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+		"userEmail":  fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+		"password":   acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceOrgUnitMembership(testMembershipVals, "googleworkspace_user.jim.primary_email, googleworkspace_user.dwight.primary_email"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_org_unit_membership.test", "member_emails.#", "2"),
+					resource.TestCheckResourceAttr("googleworkspace_org_unit_membership.test", "pending_emails.#", "0"),
+					resource.TestCheckResourceAttrSet("googleworkspace_org_unit_membership.test", "org_unit_id"),
+				),
+			},
+			{
+				Config: testAccResourceOrgUnitMembership(testMembershipVals, "googleworkspace_user.jim.primary_email"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_org_unit_membership.test", "member_emails.#", "1"),
+					resource.TestCheckResourceAttr("googleworkspace_org_unit_membership.test", "pending_emails.#", "0"),
+				),
+			},
+			{
+				// the members are moved to the other org unit in place
+				Config: testAccResourceOrgUnitMembership_otherOrgUnit(testMembershipVals),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("googleworkspace_org_unit_membership.test", "org_unit_id",
+						"googleworkspace_org_unit.other", "org_unit_id"),
+					resource.TestCheckResourceAttr("googleworkspace_org_unit_membership.test", "member_emails.#", "1"),
+					resource.TestCheckResourceAttr("googleworkspace_org_unit_membership.test", "pending_emails.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceOrgUnitMembership_maxMoves(t *testing.T) {
+	t.Parallel()
+
+	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+
+	if domainName == "" {
+		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
+	}
+
+	testMembershipVals := map[string]interface{}{
+		"domainName": domainName,
+		"ouName":     fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+		"userEmail":  fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+		"password":   acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceOrgUnitMembership_maxMoves(testMembershipVals),
+				ExpectError: regexp.MustCompile("more than max_moves"),
+			},
+		},
+	})
+}
+
+func testAccResourceOrgUnitMembershipUsers(testMembershipVals map[string]interface{}) string {
+	return Nprintf(`
+resource "googleworkspace_org_unit" "test" {
+  name                 = "%{ouName}"
+  parent_org_unit_path = "/"
+}
+
+resource "googleworkspace_org_unit" "other" {
+  name                 = "%{ouName}-other"
+  parent_org_unit_path = "/"
+}
+
+resource "googleworkspace_user" "jim" {
+  primary_email = "%{userEmail}-jim@%{domainName}"
+  password      = "%{password}"
+
+  name {
+    family_name = "Halpert"
+    given_name  = "Jim"
+  }
+
+  lifecycle {
+    ignore_changes = [org_unit_path]
+  }
+}
+
+resource "googleworkspace_user" "dwight" {
+  primary_email = "%{userEmail}-dwight@%{domainName}"
+  password      = "%{password}"
+
+  name {
+    family_name = "Schrute"
+    given_name  = "Dwight"
+  }
+
+  lifecycle {
+    ignore_changes = [org_unit_path]
+  }
+}
+`, testMembershipVals)
+}
+
+func testAccResourceOrgUnitMembership(testMembershipVals map[string]interface{}, users string) string {
+	return testAccResourceOrgUnitMembershipUsers(testMembershipVals) + fmt.Sprintf(`
+resource "googleworkspace_org_unit_membership" "test" {
+  org_unit_path = googleworkspace_org_unit.test.org_unit_path
+  users         = [%s]
+}
+`, users)
+}
+
+func testAccResourceOrgUnitMembership_maxMoves(testMembershipVals map[string]interface{}) string {
+	return testAccResourceOrgUnitMembershipUsers(testMembershipVals) + `
+resource "googleworkspace_org_unit_membership" "test" {
+  org_unit_path = googleworkspace_org_unit.test.org_unit_path
+  users         = [googleworkspace_user.jim.primary_email, googleworkspace_user.dwight.primary_email]
+  max_moves     = 1
+}
+`
+}
+
+func testAccResourceOrgUnitMembership_otherOrgUnit(testMembershipVals map[string]interface{}) string {
+	return testAccResourceOrgUnitMembershipUsers(testMembershipVals) + `
+resource "googleworkspace_org_unit_membership" "test" {
+  org_unit_path = googleworkspace_org_unit.other.org_unit_path
+  users         = [googleworkspace_user.jim.primary_email]
+}
+`
+}
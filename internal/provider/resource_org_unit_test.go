@@ -33,6 +33,36 @@ func TestAccResourceOrgUnit_basic(t *testing.T) {
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: []string{"etag"},
 			},
+			{
+				// imports by `org_unit_path`
+				ResourceName:            "googleworkspace_org_unit.my-org-unit",
+				ImportState:             true,
+				ImportStateId:           fmt.Sprintf("/%s", ouName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"etag"},
+			},
+		},
+	})
+}
+
+func TestAccResourceOrgUnit_adoptExisting(t *testing.T) {
+	t.Parallel()
+
+	ouName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceOrgUnit_basic(ouName),
+			},
+			{
+				Config: testAccResourceOrgUnit_adoptExisting(ouName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("googleworkspace_org_unit.adopted", "id", "googleworkspace_org_unit.my-org-unit", "id"),
+				),
+			},
 		},
 	})
 }
@@ -132,3 +162,15 @@ resource "googleworkspace_org_unit" "my-org-unit" {
 }
 `, ouName)
 }
+
+func testAccResourceOrgUnit_adoptExisting(ouName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "googleworkspace_org_unit" "adopted" {
+  name                 = googleworkspace_org_unit.my-org-unit.name
+  parent_org_unit_path = googleworkspace_org_unit.my-org-unit.parent_org_unit_path
+  adopt_existing       = true
+}
+`, testAccResourceOrgUnit_basic(ouName))
+}
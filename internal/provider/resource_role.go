@@ -5,6 +5,8 @@ package googleworkspace
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log"
 	"strconv"
 
@@ -26,7 +28,7 @@ func resourceRole() *schema.Resource {
 		DeleteContext: resourceRoleDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceRoleImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -243,3 +245,53 @@ func setRole(d *schema.ResourceData, role *directory.Role) diag.Diagnostics {
 
 	return diags
 }
+
+// resourceRoleImport resolves the role's name to its ID, numeric IDs are imported as is
+func resourceRoleImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if _, err := strconv.ParseInt(d.Id(), 10, 64); err == nil {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	client := meta.(*apiClient)
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return nil, fmt.Errorf("%s", diags[0].Summary)
+	}
+
+	rolesService, diags := GetRolesService(directoryService)
+	if diags.HasError() {
+		return nil, fmt.Errorf("%s", diags[0].Summary)
+	}
+
+	role, err := findRoleByName(ctx, rolesService, client.Customer, d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(strconv.FormatInt(role.RoleId, 10))
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// findRoleByName lists the roles of the customer and returns the one with the given name
+func findRoleByName(ctx context.Context, rolesService *directory.RolesService, customer, name string) (*directory.Role, error) {
+	var role *directory.Role
+	if err := rolesService.List(customer).Pages(ctx, func(roles *directory.Roles) error {
+		for _, r := range roles.Items {
+			if r.RoleName == name {
+				role = r
+				return errors.New("role was found") // return error to stop pagination
+			}
+		}
+		return nil
+	}); role == nil && err != nil {
+		return nil, err
+	}
+
+	if role == nil {
+		return nil, fmt.Errorf("No role with name %q", name)
+	}
+
+	return role, nil
+}
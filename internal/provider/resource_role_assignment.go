@@ -5,6 +5,7 @@ package googleworkspace
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"strconv"
 	"strings"
@@ -25,7 +26,7 @@ func resourceRoleAssignment() *schema.Resource {
 		DeleteContext: resourceRoleAssignmentDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceRoleAssignmentImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -208,3 +209,66 @@ func resourceRoleAssignmentDelete(ctx context.Context, d *schema.ResourceData, m
 
 	return diags
 }
+
+// resourceRoleAssignmentImport resolves an ID in the format `{role_name}/{assignee_email}` to the ID of
+// the role assignment, numeric IDs are imported as is
+func resourceRoleAssignmentImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if _, err := strconv.ParseInt(d.Id(), 10, 64); err == nil {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	// role names may contain slashes, emails can't
+	i := strings.LastIndex(d.Id(), "/")
+	if i <= 0 || i == len(d.Id())-1 {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected {role_assignment_id} or {role_name}/{assignee_email}", d.Id())
+	}
+	roleName, assigneeEmail := d.Id()[:i], d.Id()[i+1:]
+
+	client := meta.(*apiClient)
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return nil, fmt.Errorf("%s", diags[0].Summary)
+	}
+
+	rolesService, diags := GetRolesService(directoryService)
+	if diags.HasError() {
+		return nil, fmt.Errorf("%s", diags[0].Summary)
+	}
+
+	roleAssignmentsService, diags := GetRoleAssignmentsService(directoryService)
+	if diags.HasError() {
+		return nil, fmt.Errorf("%s", diags[0].Summary)
+	}
+
+	role, err := findRoleByName(ctx, rolesService, client.Customer, roleName)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	err = roleAssignmentsService.List(client.Customer).RoleId(strconv.FormatInt(role.RoleId, 10)).UserKey(assigneeEmail).Pages(ctx, func(resp *directory.RoleAssignments) error {
+		for _, ra := range resp.Items {
+			if ra.RoleId == role.RoleId {
+				ids = append(ids, strconv.FormatInt(ra.RoleAssignmentId, 10))
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing assignments of role %q to %s: %s", roleName, assigneeEmail, err)
+	}
+
+	switch len(ids) {
+	case 0:
+		return nil, fmt.Errorf("No assignment of role %q to %s", roleName, assigneeEmail)
+	case 1:
+		d.SetId(ids[0])
+	default:
+		return nil, fmt.Errorf("role %q is assigned to %s in several scopes, import one of the role assignment IDs instead: %s",
+			roleName, assigneeEmail, strings.Join(ids, ", "))
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -40,6 +40,14 @@ func TestAccResourceRoleAssignment_basic(t *testing.T) {
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: []string{"etag"},
 			},
+			{
+				// imports by `{role_name}/{assignee_email}`
+				ResourceName:            "googleworkspace_role_assignment.test",
+				ImportState:             true,
+				ImportStateId:           fmt.Sprintf("_GROUPS_ADMIN_ROLE/%s@%s", data["userEmail"], domainName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"etag"},
+			},
 		},
 	})
 }
@@ -14,12 +14,14 @@ import (
 func TestAccResourceRole_basic(t *testing.T) {
 	t.Parallel()
 
+	roleName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
 	resource.Test(t, resource.TestCase{
 		PreCheck:          func() { testAccPreCheck(t) },
 		ProviderFactories: providerFactories,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccRole_basic(fmt.Sprintf("tf-test-%s", acctest.RandString(10)), "test"),
+				Config: testAccRole_basic(roleName, "test"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("googleworkspace_role.test", "privileges.#", "9"),
 				),
@@ -30,6 +32,14 @@ func TestAccResourceRole_basic(t *testing.T) {
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: []string{"etag"},
 			},
+			{
+				// imports by `name`
+				ResourceName:            "googleworkspace_role.test",
+				ImportState:             true,
+				ImportStateId:           roleName,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"etag"},
+			},
 		},
 	})
 }
@@ -0,0 +1,223 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	directory "google.golang.org/api/admin/directory/v1"
+)
+
+func resourceSuperAdmin() *schema.Resource {
+	return &schema.Resource{
+		Description: "Super Admin resource grants super administrator privileges to a Google Workspace User, " +
+			"so the grant can be reviewed separately from the user's identity data. It should not be used " +
+			"alongside the `is_admin` attribute of `googleworkspace_user` for the same user. Destroying the " +
+			"resource revokes the privileges. Super Admin resides under the " +
+			"`https://www.googleapis.com/auth/admin.directory.user` client scope.",
+
+		CreateContext: resourceSuperAdminCreate,
+		ReadContext:   resourceSuperAdminRead,
+		UpdateContext: resourceSuperAdminUpdate,
+		DeleteContext: resourceSuperAdminDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceSuperAdminImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The ID of this resource, in the format `users/{user_id}/superAdmin`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"user_id": {
+				Description: "The user's primary email address, or unique user ID. The unique user ID is stored " +
+					"in the state, so that the grant keeps following the user when their primary email changes.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: diffSuppressSuperAdminUserId,
+			},
+			"description": {
+				Description: "The justification for granting super administrator privileges to the user. It is " +
+					"only kept in the Terraform state, so that every grant is documented where it is reviewed. " +
+					"It is not set when the resource is imported.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+			},
+			"primary_email": {
+				Description: "The primary email address of the user.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceSuperAdminCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return diags
+	}
+
+	usersService, diags := GetUsersService(directoryService)
+	if diags.HasError() {
+		return diags
+	}
+
+	userKey := d.Get("user_id").(string)
+	log.Printf("[DEBUG] Creating Super Admin for user %s", userKey)
+
+	// the email is resolved to the immutable user ID, which is used from then on
+	user, err := usersService.Get(userKey).Fields("id").Do()
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error retrieving user %s: %s", userKey, err))
+	}
+	userId := user.Id
+
+	err = setSuperAdminStatus(ctx, usersService, userId, true, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("users/%s/superAdmin", userId))
+	d.Set("user_id", userId)
+
+	log.Printf("[DEBUG] Finished creating Super Admin for user %s", userId)
+
+	return resourceSuperAdminRead(ctx, d, meta)
+}
+
+func resourceSuperAdminRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return diags
+	}
+
+	usersService, diags := GetUsersService(directoryService)
+	if diags.HasError() {
+		return diags
+	}
+
+	userId := d.Get("user_id").(string)
+	log.Printf("[DEBUG] Getting Super Admin for user %s", userId)
+
+	user, err := usersService.Get(userId).Do()
+	if err != nil {
+		return handleNotFoundError(err, d, d.Id())
+	}
+
+	if !user.IsAdmin {
+		log.Printf("[WARN] Removing Super Admin %q because the user is no longer a super admin", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.SetId(fmt.Sprintf("users/%s/superAdmin", user.Id))
+	d.Set("user_id", user.Id)
+	d.Set("primary_email", user.PrimaryEmail)
+
+	log.Printf("[DEBUG] Finished getting Super Admin for user %s", userId)
+
+	return diags
+}
+
+func resourceSuperAdminUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// only the description can be updated, which is not sent to the API
+	log.Printf("[DEBUG] Updating Super Admin %q", d.Id())
+
+	return resourceSuperAdminRead(ctx, d, meta)
+}
+
+func resourceSuperAdminDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return diags
+	}
+
+	usersService, diags := GetUsersService(directoryService)
+	if diags.HasError() {
+		return diags
+	}
+
+	userId := d.Get("user_id").(string)
+	log.Printf("[DEBUG] Deleting Super Admin for user %s", userId)
+
+	err := setSuperAdminStatus(ctx, usersService, userId, false, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, d.Id())
+	}
+
+	log.Printf("[DEBUG] Finished deleting Super Admin for user %s", userId)
+
+	return diags
+}
+
+func resourceSuperAdminImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 3 || parts[0] != "users" || parts[1] == "" || parts[2] != "superAdmin" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected users/{user_id}/superAdmin", d.Id())
+	}
+
+	d.Set("user_id", parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// diffSuppressSuperAdminUserId suppresses the diff between the unique user ID in the state and the
+// primary email of the same user in the configuration
+func diffSuppressSuperAdminUserId(k, old, new string, d *schema.ResourceData) bool {
+	if strings.EqualFold(old, new) {
+		return true
+	}
+
+	primaryEmail, _ := d.Get("primary_email").(string)
+	return primaryEmail != "" && strings.EqualFold(new, primaryEmail)
+}
+
+// setSuperAdminStatus grants or revokes super administrator privileges, and waits until the user
+// reflects the new status, as makeAdmin is eventually consistent
+func setSuperAdminStatus(ctx context.Context, usersService *directory.UsersService, userId string, status bool, timeout time.Duration) error {
+	err := usersService.MakeAdmin(userId, &directory.UserMakeAdmin{
+		Status:          status,
+		ForceSendFields: []string{"Status"},
+	}).Do()
+	if err != nil {
+		return err
+	}
+
+	return retryTimeDuration(ctx, timeout, func() error {
+		user, retryErr := usersService.Get(userId).Do()
+		if retryErr != nil {
+			return fmt.Errorf("unexpected error during retries of super admin: %s", retryErr)
+		}
+
+		if user.IsAdmin != status {
+			return fmt.Errorf("timed out while waiting for super admin status to be %t", status)
+		}
+
+		return nil
+	})
+}
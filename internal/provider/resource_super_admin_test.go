@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceSuperAdmin_basic(t *testing.T) {
+	t.Parallel()
+
+	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+
+	if domainName == "" {
+		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
+	}
+
+	testUserVals := map[string]interface{}{
+		"domainName": domainName,
+		"userEmail":  fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+		"password":   acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSuperAdmin(testUserVals, "Break glass account"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("googleworkspace_super_admin.test", "primary_email", "googleworkspace_user.test", "primary_email"),
+					// the email is resolved to the unique user ID
+					resource.TestCheckResourceAttrPair("googleworkspace_super_admin.test", "user_id", "googleworkspace_user.test", "id"),
+				),
+			},
+			{
+				ResourceName:            "googleworkspace_super_admin.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"description"},
+			},
+			{
+				ResourceName:            "googleworkspace_super_admin.test",
+				ImportState:             true,
+				ImportStateId:           fmt.Sprintf("users/%s@%s/superAdmin", testUserVals["userEmail"], domainName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"description"},
+			},
+			{
+				Config: testAccResourceSuperAdmin(testUserVals, "Break glass account, reviewed yearly"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_super_admin.test", "description", "Break glass account, reviewed yearly"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceSuperAdmin(testUserVals map[string]interface{}, description string) string {
+	testUserVals["description"] = description
+
+	return Nprintf(`
+resource "googleworkspace_user" "test" {
+  primary_email = "%{userEmail}@%{domainName}"
+  password      = "%{password}"
+
+  name {
+    family_name = "Scott"
+    given_name  = "Michael"
+  }
+}
+
+resource "googleworkspace_super_admin" "test" {
+  user_id     = googleworkspace_user.test.primary_email
+  description = "%{description}"
+}
+`, testUserVals)
+}
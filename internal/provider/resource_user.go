@@ -4,50 +4,36 @@
 package googleworkspace
 
 import (
+	"bytes"
 	"context"
+	"crypto/md5"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"log"
+	"math/big"
 	"net/mail"
+	"os"
 	"reflect"
 	"sort"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/GehirnInc/crypt/sha512_crypt"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mitchellh/go-homedir"
 
 	datatransfer "google.golang.org/api/admin/datatransfer/v1"
 	directory "google.golang.org/api/admin/directory/v1"
 	"google.golang.org/api/googleapi"
 )
 
-func diffSuppressEmails(k, old, new string, d *schema.ResourceData) bool {
-	stateEmails, configEmails := d.GetChange("emails")
-
-	// User aliases and other alternate emails (added by Google and denoted by no `type`),
-	// are auto-added to the email list, even if it's not configured that way.
-	// Only show a diff if the other emails differ.
-	subsetEmails := []interface{}{}
-
-	aliases := listOfInterfacestoStrings(d.Get("aliases").([]interface{}))
-
-	for _, se := range stateEmails.([]interface{}) {
-		email := se.(map[string]interface{})
-		emailAddress := email["address"].(string)
-		emailType := email["type"].(string)
-
-		if emailType == "" || stringInSlice(aliases, emailAddress) {
-			continue
-		}
-
-		subsetEmails = append(subsetEmails, se)
-	}
-
-	return reflect.DeepEqual(subsetEmails, configEmails.([]interface{}))
-}
-
 func diffSuppressAliases(k, old, new string, d *schema.ResourceData) bool {
 	// Get the old and new aliases (stateAliases and configAliases)
 	stateAliases, configAliases := d.GetChange("aliases")
@@ -142,7 +128,7 @@ func transformCustomSchemasTo2DMap(customSchemas []interface{}) map[string]map[s
 }
 
 func resourceUser() *schema.Resource {
-	return &schema.Resource{
+	r := &schema.Resource{
 		// This description is used by the documentation generator and the language server.
 		Description: "User resource manages Google Workspace Users. User resides " +
 			"under the `https://www.googleapis.com/auth/admin.directory.user` client scope.\n" +
@@ -161,9 +147,17 @@ func resourceUser() *schema.Resource {
 		},
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceUserImport,
+		},
+
+		CustomizeDiff: resourceUserCustomizeDiff,
+
+		ValidateRawResourceConfigFuncs: []schema.ValidateRawResourceConfigFunc{
+			validation.PreferWriteOnlyAttribute(cty.GetAttrPath("password"), cty.GetAttrPath("password_wo")),
 		},
 
+		SchemaVersion: 1,
+
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Description: "The unique ID for the user.",
@@ -182,24 +176,78 @@ func resourceUser() *schema.Resource {
 					"ASCII characters. A minimum of 8 characters is required. The maximum length is 100 characters. " +
 					"As the API does not return the value of password, this field is write-only, and the value stored " +
 					"in the state will be what is provided in the configuration. The field is required on create and will " +
-					"be empty on import.",
+					"be empty on import. Use `password_wo` to avoid storing the password in the state.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Sensitive:        true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(8, 100)),
+				ConflictsWith:    []string{"password_wo", "generated_password"},
+			},
+			"password_wo": {
+				Description: "Write-only variant of `password`. The value is sent to the API but never stored in the " +
+					"plan or state, so it can be sourced from an ephemeral resource. As the value is not persisted, " +
+					"changes to it are only applied when `password_version` changes, which is required along with it. " +
+					"Requires Terraform 1.11 or later. Either `password` or `password_wo` is required on create.",
 				Type:             schema.TypeString,
 				Optional:         true,
 				Sensitive:        true,
+				WriteOnly:        true,
 				ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(8, 100)),
+				ConflictsWith:    []string{"password", "generated_password"},
+				RequiredWith:     []string{"password_version"},
+			},
+			"password_version": {
+				Description: "An arbitrary value used to trigger an update of the password set with `password_wo`. " +
+					"Change this value (e.g. increment it) whenever the password should be rotated.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"password_wo"},
+			},
+			"generated_password": {
+				Description: "Generates a random initial password when the user is created, instead of setting " +
+					"`password` or `password_wo`. The plaintext password is only written to `output_file` and is never " +
+					"stored in the state. The file is only written once the user has been created. If `hash_function` is set, " +
+					"the password is hashed before it is sent to the API. `change_password_at_next_login` is forced to " +
+					"`true` when using this block, unless an existing user is adopted. Changes to this block after the user is created have no effect.",
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"password", "password_wo"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"length": {
+							Description:      "The length of the generated password.",
+							Type:             schema.TypeInt,
+							Optional:         true,
+							Default:          24,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(12, 100)),
+						},
+						"output_file": {
+							Description: "The path of the local file the generated password is written to. The file " +
+								"is created with `0600` permissions, and overwritten if it already exists.",
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
 			},
 			"hash_function": {
 				Description: "Stores the hash format of the password property. We recommend sending the password " +
 					"property value as a base 16 bit hexadecimal-encoded hash value. Set the hashFunction values " +
-					"as either the SHA-1, MD5, or crypt hash format.",
-				Type:     schema.TypeString,
-				Optional: true,
+					"as either the SHA-1, MD5, or crypt hash format. When used with `generated_password`, the " +
+					"provider hashes the generated password with this function.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"SHA-1", "MD5", "crypt"}, true)),
 			},
 			"is_admin": {
-				Description: "Indicates a user with super admininistrator privileges.",
-				Type:        schema.TypeBool,
-				Optional:    true,
-				Computed:    true,
+				Description: "Indicates a user with super admininistrator privileges. Super administrator privileges " +
+					"should be managed with `googleworkspace_super_admin` instead, so they are granted separately from " +
+					"the user's identity data. When not set, the privileges of the user are left unchanged.",
+				Type:       schema.TypeBool,
+				Optional:   true,
+				Computed:   true,
+				Deprecated: "Use googleworkspace_super_admin to manage super administrator privileges instead.",
 			},
 			"is_delegated_admin": {
 				Description: "Indicates if the user is a delegated administrator.",
@@ -219,9 +267,11 @@ func resourceUser() *schema.Resource {
 			},
 			"change_password_at_next_login": {
 				Description: "Indicates if the user is forced to change their password at next login. This setting " +
-					"doesn't apply when the user signs in via a third-party identity provider.",
+					"doesn't apply when the user signs in via a third-party identity provider. It is forced to `true` " +
+					"when the user is created with `generated_password`.",
 				Type:     schema.TypeBool,
 				Optional: true,
+				Computed: true,
 			},
 			"ip_allowlist": {
 				Description: "If true, the user's IP address is added to the allow list.",
@@ -269,11 +319,12 @@ func resourceUser() *schema.Resource {
 			// TODO: (mbang) Add ValidateDiagFunc for max size when it's allowed on lists
 			// (https://github.com/hashicorp/terraform-plugin-sdk/issues/156)
 			"emails": {
-				Description:      "A list of the user's email addresses. The maximum allowed data size is 10Kb.",
-				Type:             schema.TypeList,
-				Optional:         true,
-				Computed:         true,
-				DiffSuppressFunc: diffSuppressEmails,
+				Description: "A list of the user's email addresses. The maximum allowed data size is 10Kb. " +
+					"Addresses added by Google without a type, such as the primary email and aliases, are not included.",
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Set:      userNestedSetHash("address", "type", "custom_type"),
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"address": {
@@ -312,8 +363,9 @@ func resourceUser() *schema.Resource {
 			"external_ids": {
 				Description: "A list of external IDs for the user, such as an employee or network ID. " +
 					"The maximum allowed data size is 2Kb.",
-				Type:     schema.TypeList,
+				Type:     schema.TypeSet,
 				Optional: true,
+				Set:      userNestedSetHash("type", "custom_type", "value"),
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"custom_type": {
@@ -345,8 +397,9 @@ func resourceUser() *schema.Resource {
 			"relations": {
 				Description: "A list of the user's relationships to other users. " +
 					"The maximum allowed data size for this field is 2Kb.",
-				Type:     schema.TypeList,
+				Type:     schema.TypeSet,
 				Optional: true,
+				Set:      userNestedSetHash("type", "custom_type", "value"),
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"custom_type": {
@@ -378,6 +431,22 @@ func resourceUser() *schema.Resource {
 					},
 				},
 			},
+			"keep_old_email_as_alias": {
+				Description: "If true, when `primary_email` changes, the old primary email is kept as an alias of the " +
+					"user, and recorded in `previous_email_aliases` instead of `aliases`. Otherwise it is deleted, " +
+					"unless it is one of the configured `aliases`.",
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"previous_email_aliases": {
+				Description: "The previous primary emails of the user kept as aliases because of `keep_old_email_as_alias`. " +
+					"They are not included in `aliases`, unless configured there.",
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 			"aliases": {
 				Description:      "asps.list of the user's alias email addresses.",
 				Type:             schema.TypeList,
@@ -406,8 +475,9 @@ func resourceUser() *schema.Resource {
 			// (https://github.com/hashicorp/terraform-plugin-sdk/issues/156)
 			"addresses": {
 				Description: "A list of the user's addresses. The maximum allowed data size is 10Kb.",
-				Type:        schema.TypeList,
+				Type:        schema.TypeSet,
 				Optional:    true,
+				Set:         userNestedSetHash("type", "custom_type", "formatted", "street_address", "extended_address", "po_box", "locality", "region", "postal_code", "country", "country_code"),
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"country": {
@@ -490,8 +560,9 @@ func resourceUser() *schema.Resource {
 			// (https://github.com/hashicorp/terraform-plugin-sdk/issues/156)
 			"organizations": {
 				Description: "A list of organizations the user belongs to. The maximum allowed data size is 10Kb.",
-				Type:        schema.TypeList,
+				Type:        schema.TypeSet,
 				Optional:    true,
+				Set:         userNestedSetHash("type", "custom_type", "name", "department", "title", "description", "cost_center", "domain", "location", "symbol", "full_time_equivalent"),
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"cost_center": {
@@ -575,8 +646,9 @@ func resourceUser() *schema.Resource {
 			// (https://github.com/hashicorp/terraform-plugin-sdk/issues/156)
 			"phones": {
 				Description: "A list of the user's phone numbers. The maximum allowed data size is 1Kb.",
-				Type:        schema.TypeList,
+				Type:        schema.TypeSet,
 				Optional:    true,
+				Set:         userNestedSetHash("type", "custom_type", "value"),
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"custom_type": {
@@ -629,9 +701,10 @@ func resourceUser() *schema.Resource {
 			// (https://github.com/hashicorp/terraform-plugin-sdk/issues/156)
 			"languages": {
 				Description: "A list of the user's languages. The maximum allowed data size is 1Kb.",
-				Type:        schema.TypeList,
+				Type:        schema.TypeSet,
 				Optional:    true,
 				Computed:    true,
+				Set:         userNestedSetHash("language_code", "custom_language"),
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"custom_language": {
@@ -667,8 +740,9 @@ func resourceUser() *schema.Resource {
 			// TODO: (mbang) AtLeastOneOf (https://github.com/hashicorp/terraform-plugin-sdk/issues/470)
 			"posix_accounts": {
 				Description: "A list of POSIX account information for the user.",
-				Type:        schema.TypeList,
+				Type:        schema.TypeSet,
 				Optional:    true,
+				Set:         userNestedSetHash("username", "uid", "gid", "system_id", "account_id", "home_directory", "shell", "gecos", "operating_system_type"),
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"account_id": {
@@ -748,8 +822,9 @@ func resourceUser() *schema.Resource {
 			// (https://github.com/hashicorp/terraform-plugin-sdk/issues/156)
 			"ssh_public_keys": {
 				Description: "A list of SSH public keys. The maximum allowed data size is 10Kb.",
-				Type:        schema.TypeList,
+				Type:        schema.TypeSet,
 				Optional:    true,
+				Set:         userNestedSetHash("key"),
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"expiration_time_usec": {
@@ -774,8 +849,9 @@ func resourceUser() *schema.Resource {
 			// (https://github.com/hashicorp/terraform-plugin-sdk/issues/156)
 			"websites": {
 				Description: "A list of the user's websites. The maximum allowed data size is 2Kb.",
-				Type:        schema.TypeList,
+				Type:        schema.TypeSet,
 				Optional:    true,
+				Set:         userNestedSetHash("type", "custom_type", "value"),
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"custom_type": {
@@ -814,8 +890,9 @@ func resourceUser() *schema.Resource {
 			// (https://github.com/hashicorp/terraform-plugin-sdk/issues/156)
 			"locations": {
 				Description: "A list of the user's locations. The maximum allowed data size is 10Kb.",
-				Type:        schema.TypeList,
+				Type:        schema.TypeSet,
 				Optional:    true,
+				Set:         userNestedSetHash("type", "custom_type", "area", "building_id", "floor_name", "floor_section", "desk_code"),
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"area": {
@@ -875,8 +952,9 @@ func resourceUser() *schema.Resource {
 			// (https://github.com/hashicorp/terraform-plugin-sdk/issues/156)
 			"keywords": {
 				Description: "A list of the user's keywords. The maximum allowed data size is 1Kb.",
-				Type:        schema.TypeList,
+				Type:        schema.TypeSet,
 				Optional:    true,
+				Set:         userNestedSetHash("type", "custom_type", "value"),
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"custom_type": {
@@ -912,6 +990,70 @@ func resourceUser() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"sign_out_on_suspend": {
+				Description: "If true, when `suspended` changes from `false` to `true`, the user is signed out of all " +
+					"web and device sessions and all OAuth tokens granted to third-party applications are deleted, before " +
+					"the user is suspended. Signing out the user requires the " +
+					"`https://www.googleapis.com/auth/admin.directory.user.security` scope. If signing out fails, the user " +
+					"is not suspended and the next apply retries.",
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"last_sign_out_time": {
+				Description: "The time the user was last signed out because of `sign_out_on_suspend`, in RFC 3339 format.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"last_revoked_client_ids": {
+				Description: "The Client IDs of the applications whose OAuth tokens were deleted when the user was " +
+					"last signed out because of `sign_out_on_suspend`.",
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"deletion_policy": {
+				Description: "What happens to the user when the resource is destroyed. `DELETE` deletes the user, " +
+					"which can be undone within 20 days. `SUSPEND` suspends the user, `ARCHIVE` archives the user, " +
+					"and `ABANDON` leaves the user untouched; in all three cases the user is only removed from the state. " +
+					"`on_delete_data_transfer` only applies to `DELETE`. Defaults to `DELETE`.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "DELETE",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"DELETE", "SUSPEND", "ARCHIVE", "ABANDON"}, false)),
+			},
+			"update_mode": {
+				Description: "How changes are sent to the API. Acceptable values are: " +
+					"\n\t- `UPDATE`: The user is updated with `users.update`, nested lists are replaced with the configured " +
+					"values and any value set outside of Terraform is reported as drift. " +
+					"\n\t- `PATCH`: The user is updated with `users.patch`, and nested lists (`emails`, `phones`, " +
+					"`organizations`, `addresses`, ...), `recovery_email` and `recovery_phone` are only managed when present " +
+					"in the configuration. Values that are not configured are left untouched, e.g. when they are owned by " +
+					"GCDS, an HRIS sync or the user, and are not reported as drift. Removing them from the configuration " +
+					"stops managing them rather than clearing them.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "UPDATE",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"UPDATE", "PATCH"}, false)),
+			},
+			"adopt_existing": {
+				Description: "If true, and a user with the same `primary_email` already exists (e.g. created in the " +
+					"Admin Console or by GCDS), the existing user is adopted into the state and updated to match the " +
+					"configuration, instead of the create failing. The password of an adopted user is never changed: " +
+					"`password`, `password_wo` and `generated_password` are ignored, and no password is generated. " +
+					"The fields of the existing user that are overwritten are reported in a warning. Adoption fails if " +
+					"`primary_email` is an alias of another user.",
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"undelete_on_create": {
+				Description: "If true, when creating the user, a user deleted within the last 20 days with the same " +
+					"`primary_email` is restored into `org_unit_path` (or the top-level org unit) and updated to match " +
+					"the configuration, instead of a new user being created.",
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			"on_delete_data_transfer": {
 				Description: "Holds the information about data transfer prior to deletion of the user's account. " +
 					"The recipient and what gets transferred is customizable",
@@ -988,8 +1130,9 @@ func resourceUser() *schema.Resource {
 				Description: "The user's Instant Messenger (IM) accounts. A user account can have multiple ims " +
 					"properties. But, only one of these ims properties can be the primary IM contact. " +
 					"The maximum allowed data size is 2Kb.",
-				Type:     schema.TypeList,
+				Type:     schema.TypeSet,
 				Optional: true,
+				Set:      userNestedSetHash("type", "custom_type", "protocol", "custom_protocol", "im"),
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"custom_protocol": {
@@ -1038,7 +1181,9 @@ func resourceUser() *schema.Resource {
 				},
 			},
 			"custom_schemas": {
-				Description:      "Custom fields of the user.",
+				Description: "Custom fields of the user. The values are validated against the schema definitions " +
+					"during plan, and a schema that does not exist fails the plan. Schemas whose name is only known on " +
+					"apply, e.g. a `googleworkspace_schema` created in the same run, are validated on apply.",
 				Type:             schema.TypeList,
 				Optional:         true,
 				DiffSuppressFunc: diffSuppressCustomSchemas,
@@ -1099,6 +1244,17 @@ func resourceUser() *schema.Resource {
 			},
 		},
 	}
+
+	// Version 0 modeled the nested lists (emails, phones, ...) as lists rather than sets
+	r.StateUpgraders = []schema.StateUpgrader{
+		{
+			Version: 0,
+			Type:    resourceUserResourceV0(r).CoreConfigSchema().ImpliedType(),
+			Upgrade: resourceUserStateUpgradeV0,
+		},
+	}
+
+	return r
 }
 
 func resourceUserCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -1107,15 +1263,13 @@ func resourceUserCreate(ctx context.Context, d *schema.ResourceData, meta interf
 	// use the meta value to retrieve your client from the provider configure method
 	client := meta.(*apiClient)
 
-	if d.Get("password").(string) == "" {
-		diags = append(diags, diag.Diagnostic{
-			Severity: diag.Error,
-			Summary:  fmt.Sprintf("Password is required when creating a new user"),
-		})
-
+	password, diags := getUserPassword(d)
+	if diags.HasError() {
 		return diags
 	}
 
+	hashFunction := d.Get("hash_function").(string)
+
 	primaryEmail := d.Get("primary_email").(string)
 	log.Printf("[DEBUG] Creating User %q: %#v", d.Id(), primaryEmail)
 
@@ -1129,28 +1283,76 @@ func resourceUserCreate(ctx context.Context, d *schema.ResourceData, meta interf
 		return diags
 	}
 
+	var existingUser *directory.User
+	if d.Get("adopt_existing").(bool) {
+		var err error
+		existingUser, err = findExistingUser(usersService, primaryEmail)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	// the password of an adopted user is never changed, and no password is generated for it
+	var adoptionDiags diag.Diagnostics
+	var generatedPassword string
+	if existingUser != nil {
+		if password != "" || len(d.Get("generated_password").([]interface{})) > 0 {
+			adoptionDiags = append(adoptionDiags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("The password of the adopted user %s is not changed", primaryEmail),
+			})
+		}
+
+		password = ""
+		hashFunction = ""
+	} else if len(d.Get("generated_password").([]interface{})) > 0 {
+		generatedPassword, password, hashFunction, diags = generateInitialUserPassword(d)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	if password == "" && existingUser == nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Password is required when creating a new user"),
+		})
+
+		return diags
+	}
+
+	posixAccounts, err := expandUserPosixAccounts(d.Get("posix_accounts"))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	sshPublicKeys, err := expandUserSshPublicKeys(d.Get("ssh_public_keys"))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	userObj := directory.User{
 		PrimaryEmail:               primaryEmail,
-		Password:                   d.Get("password").(string),
-		HashFunction:               d.Get("hash_function").(string),
+		Password:                   password,
+		HashFunction:               hashFunction,
 		Suspended:                  d.Get("suspended").(bool),
 		ChangePasswordAtNextLogin:  d.Get("change_password_at_next_login").(bool),
 		IpWhitelisted:              d.Get("ip_allowlist").(bool),
 		Name:                       expandName(d.Get("name")),
-		Emails:                     expandInterfaceObjects(d.Get("emails")),
-		ExternalIds:                expandInterfaceObjects(d.Get("external_ids")),
-		Relations:                  expandInterfaceObjects(d.Get("relations")),
-		Addresses:                  expandInterfaceObjects(d.Get("addresses")),
-		Organizations:              expandInterfaceObjects(d.Get("organizations")),
-		Phones:                     expandInterfaceObjects(d.Get("phones")),
-		Languages:                  expandInterfaceObjects(d.Get("languages")),
-		PosixAccounts:              expandInterfaceObjects(d.Get("posix_accounts")),
-		SshPublicKeys:              expandInterfaceObjects(d.Get("ssh_public_keys")),
-		Websites:                   expandInterfaceObjects(d.Get("websites")),
-		Locations:                  expandInterfaceObjects(d.Get("locations")),
+		Emails:                     expandUserEmails(d.Get("emails")),
+		ExternalIds:                expandUserExternalIds(d.Get("external_ids")),
+		Relations:                  expandUserRelations(d.Get("relations")),
+		Addresses:                  expandUserAddresses(d.Get("addresses")),
+		Organizations:              expandUserOrganizations(d.Get("organizations")),
+		Phones:                     expandUserPhones(d.Get("phones")),
+		Languages:                  expandUserLanguages(d.Get("languages")),
+		PosixAccounts:              posixAccounts,
+		SshPublicKeys:              sshPublicKeys,
+		Websites:                   expandUserWebsites(d.Get("websites")),
+		Locations:                  expandUserLocations(d.Get("locations")),
 		IncludeInGlobalAddressList: d.Get("include_in_global_address_list").(bool),
-		Keywords:                   expandInterfaceObjects(d.Get("keywords")),
-		Ims:                        expandInterfaceObjects(d.Get("ims")),
+		Keywords:                   expandUserKeywords(d.Get("keywords")),
+		Ims:                        expandUserIms(d.Get("ims")),
 		Archived:                   d.Get("archived").(bool),
 		OrgUnitPath:                d.Get("org_unit_path").(string),
 		RecoveryEmail:              d.Get("recovery_email").(string),
@@ -1173,7 +1375,36 @@ func resourceUserCreate(ctx context.Context, d *schema.ResourceData, meta interf
 		userObj.CustomSchemas = customSchemas
 	}
 
-	user, err := usersService.Insert(&userObj).Do()
+	var deletedUser *directory.User
+	if existingUser == nil && d.Get("undelete_on_create").(bool) {
+		var err error
+		deletedUser, err = findDeletedUser(ctx, usersService, client.Customer, primaryEmail)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if generatedPassword != "" {
+		// the plan can't force it when the user may be adopted
+		userObj.ChangePasswordAtNextLogin = true
+	}
+
+	var user *directory.User
+	if existingUser != nil {
+		log.Printf("[DEBUG] Adopting existing User %q: %#v", existingUser.Id, primaryEmail)
+
+		// unless configured, adopted users keep having to change their password or not
+		if rawConfig := d.GetRawConfig(); !rawConfig.IsNull() && rawConfig.GetAttr("change_password_at_next_login").IsNull() {
+			userObj.ChangePasswordAtNextLogin = existingUser.ChangePasswordAtNextLogin
+		}
+
+		adoptionDiags = append(adoptionDiags, adoptionConflictsWarning("user", primaryEmail, userAdoptionConflicts(existingUser, &userObj))...)
+		user, err = updateUser(d, usersService, existingUser.Id, &userObj)
+	} else if deletedUser != nil {
+		user, err = undeleteUser(usersService, deletedUser, &userObj)
+	} else {
+		user, err = usersService.Insert(&userObj).Do()
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -1181,6 +1412,14 @@ func resourceUserCreate(ctx context.Context, d *schema.ResourceData, meta interf
 	d.SetId(user.Id)
 	d.Set("on_delete_data_transfer", d.Get("on_delete_data_transfer"))
 
+	// the generated password is only written once it has been set on the user
+	if generatedPassword != "" {
+		diags = writeGeneratedUserPassword(d, generatedPassword)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
 	// INSERT will respond with the User that will be created, however, it is eventually consistent
 	// After INSERT, the etag is updated along with the User (and any aliases),
 	// once we get a consistent etag, we can feel confident that our User is also consistent
@@ -1220,7 +1459,7 @@ func resourceUserCreate(ctx context.Context, d *schema.ResourceData, meta interf
 	}
 
 	log.Printf("[DEBUG] Finished creating User %q: %#v", d.Id(), primaryEmail)
-	return resourceUserRead(ctx, d, meta)
+	return append(adoptionDiags, resourceUserRead(ctx, d, meta)...)
 }
 
 func resourceUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -1264,6 +1503,11 @@ func resourceUserRead(ctx context.Context, d *schema.ResourceData, meta interfac
 		}
 	}
 
+	nested, err := decodeUserNestedLists(user)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	d.Set("primary_email", user.PrimaryEmail)
 	// password and hash_function are not returned in the response, so set them to what we defined in the config
 	d.Set("password", d.Get("password"))
@@ -1275,39 +1519,57 @@ func resourceUserRead(ctx context.Context, d *schema.ResourceData, meta interfac
 	d.Set("change_password_at_next_login", user.ChangePasswordAtNextLogin)
 	d.Set("ip_allowlist", user.IpWhitelisted)
 	d.Set("name", flattenName(user.Name))
-	d.Set("emails", flattenInterfaceObjects(user.Emails))
-	d.Set("external_ids", flattenInterfaceObjects(user.ExternalIds))
-	d.Set("relations", flattenInterfaceObjects(user.Relations))
+	// data sources, which have no update_mode, return all the emails of the user
+	_, isResource := d.Get("update_mode").(string)
+	setUserNestedList(d, "emails", flattenUserEmails(nested.Emails, !isResource))
+	setUserNestedList(d, "external_ids", flattenUserExternalIds(nested.ExternalIds))
+	setUserNestedList(d, "relations", flattenUserRelations(nested.Relations))
 	d.Set("etag", user.Etag)
-	d.Set("aliases", user.Aliases)
+	// previous emails kept as aliases are not included in aliases, the data sources don't have them
+	previousEmails, ok := d.Get("previous_email_aliases").([]interface{})
+	aliases, keptEmails := withoutPreviousEmails(user.Aliases, listOfInterfacestoStrings(previousEmails))
+	if ok {
+		d.Set("previous_email_aliases", keptEmails)
+	} else {
+		aliases = user.Aliases
+	}
+	d.Set("aliases", aliases)
 	d.Set("is_mailbox_setup", user.IsMailboxSetup)
 	d.Set("customer_id", user.CustomerId)
-	d.Set("addresses", flattenInterfaceObjects(user.Addresses))
-	d.Set("organizations", flattenInterfaceObjects(user.Organizations))
+	setUserNestedList(d, "addresses", flattenUserAddresses(nested.Addresses))
+	setUserNestedList(d, "organizations", flattenUserOrganizations(nested.Organizations))
 	d.Set("last_login_time", user.LastLoginTime)
-	d.Set("phones", flattenInterfaceObjects(user.Phones))
+	setUserNestedList(d, "phones", flattenUserPhones(nested.Phones))
 	d.Set("suspension_reason", user.SuspensionReason)
 	d.Set("thumbnail_photo_url", user.ThumbnailPhotoUrl)
-	d.Set("languages", flattenInterfaceObjects(user.Languages))
-	d.Set("posix_accounts", flattenInterfaceObjects(user.PosixAccounts))
+	setUserNestedList(d, "languages", flattenUserLanguages(nested.Languages))
+	setUserNestedList(d, "posix_accounts", flattenUserPosixAccounts(nested.PosixAccounts))
 	d.Set("creation_time", user.CreationTime)
 	d.Set("non_editable_aliases", user.NonEditableAliases)
-	d.Set("ssh_public_keys", flattenInterfaceObjects(user.SshPublicKeys))
-	d.Set("websites", flattenInterfaceObjects(user.Websites))
-	d.Set("locations", flattenInterfaceObjects(user.Locations))
+	setUserNestedList(d, "ssh_public_keys", flattenUserSshPublicKeys(nested.SshPublicKeys))
+	setUserNestedList(d, "websites", flattenUserWebsites(nested.Websites))
+	setUserNestedList(d, "locations", flattenUserLocations(nested.Locations))
 	d.Set("include_in_global_address_list", user.IncludeInGlobalAddressList)
-	d.Set("keywords", flattenInterfaceObjects(user.Keywords))
+	setUserNestedList(d, "keywords", flattenUserKeywords(nested.Keywords))
 	d.Set("on_delete_data_transfer", d.Get("on_delete_data_transfer"))
+	// deletion_policy, update_mode and undelete_on_create are not returned in the response, as they only
+	// affect Terraform. They are also not part of the data source schema, which shares this function.
+	if deletionPolicy, ok := d.Get("deletion_policy").(string); ok && deletionPolicy == "" {
+		d.Set("deletion_policy", "DELETE")
+	}
+	if updateMode, ok := d.Get("update_mode").(string); ok && updateMode == "" {
+		d.Set("update_mode", "UPDATE")
+	}
 	d.Set("deletion_time", user.DeletionTime)
 	d.Set("thumbnail_photo_etag", user.ThumbnailPhotoEtag)
-	d.Set("ims", flattenInterfaceObjects(user.Ims))
+	setUserNestedList(d, "ims", flattenUserIms(nested.Ims))
 	d.Set("custom_schemas", customSchemas)
 	d.Set("is_enrolled_in_2_step_verification", user.IsEnrolledIn2Sv)
 	d.Set("is_enforced_in_2_step_verification", user.IsEnforcedIn2Sv)
 	d.Set("archived", user.Archived)
 	d.Set("org_unit_path", user.OrgUnitPath)
-	d.Set("recovery_email", user.RecoveryEmail)
-	d.Set("recovery_phone", user.RecoveryPhone)
+	setUserScalar(d, "recovery_email", user.RecoveryEmail)
+	setUserScalar(d, "recovery_phone", user.RecoveryPhone)
 
 	d.SetId(user.Id)
 	log.Printf("[DEBUG] Finished getting User %q: %#v", d.Id(), primaryEmail)
@@ -1341,39 +1603,26 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 
 	if d.HasChange("primary_email") {
 		if d.IsNewResource() == false {
-			emailupdate := directory.User{
-				PrimaryEmail: primaryEmail,
-			}
-			_, err := usersService.Update(d.Id(), &emailupdate).Do()
-			if err != nil {
-				return diag.FromErr(err)
+			diags = renameUser(ctx, d, usersService)
+			if diags.HasError() {
+				return diags
 			}
-		}
-
-		userObj.PrimaryEmail = primaryEmail
-	}
-
-	if d.HasChange("password") {
-		userObj.Password = d.Get("password").(string)
-
-		if userObj.Password == "" {
-			forceSendFields = append(forceSendFields, "Password")
+		} else {
+			userObj.PrimaryEmail = primaryEmail
 		}
 	}
 
-	if d.HasChange("hash_function") {
-		userObj.HashFunction = d.Get("hash_function").(string)
-
-		if userObj.HashFunction == "" {
-			forceSendFields = append(forceSendFields, "HashFunction")
-		}
+	passwordForceSendFields, diags := expandUserPasswordUpdate(d, &userObj)
+	if diags.HasError() {
+		return diags
 	}
+	forceSendFields = append(forceSendFields, passwordForceSendFields...)
 
 	if d.HasChange("org_unit_path") {
 		userObj.OrgUnitPath = d.Get("org_unit_path").(string)
 	}
 
-	if d.HasChange("recovery_email") {
+	if userScalarChanged(d, "recovery_email") {
 		userObj.RecoveryEmail = d.Get("recovery_email").(string)
 
 		if userObj.RecoveryEmail == "" {
@@ -1381,7 +1630,7 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 		}
 	}
 
-	if d.HasChange("recovery_phone") {
+	if userScalarChanged(d, "recovery_phone") {
 		userObj.RecoveryPhone = d.Get("recovery_phone").(string)
 
 		if userObj.RecoveryPhone == "" {
@@ -1418,75 +1667,116 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 
 	// Nested Objects
 
+	// nested lists that are cleared are sent as null, as empty lists are omitted from the request
+	nullFields := []string{}
+
 	if d.HasChange("name") {
 		userObj.Name = expandName(d.Get("name"))
 	}
 
-	if d.HasChange("emails") {
-		emails := expandInterfaceObjects(d.Get("emails"))
-		userObj.Emails = emails
+	if userNestedListChanged(d, "emails") {
+		userObj.Emails = expandUserEmails(d.Get("emails"))
+		if userObj.Emails == nil {
+			nullFields = append(nullFields, "Emails")
+		}
 	}
 
-	if d.HasChange("external_ids") {
-		externalIds := expandInterfaceObjects(d.Get("external_ids"))
-		userObj.ExternalIds = externalIds
+	if userNestedListChanged(d, "external_ids") {
+		userObj.ExternalIds = expandUserExternalIds(d.Get("external_ids"))
+		if userObj.ExternalIds == nil {
+			nullFields = append(nullFields, "ExternalIds")
+		}
 	}
 
-	if d.HasChange("relations") {
-		emails := expandInterfaceObjects(d.Get("relations"))
-		userObj.Relations = emails
+	if userNestedListChanged(d, "relations") {
+		userObj.Relations = expandUserRelations(d.Get("relations"))
+		if userObj.Relations == nil {
+			nullFields = append(nullFields, "Relations")
+		}
 	}
 
-	if d.HasChange("addresses") {
-		addresses := expandInterfaceObjects(d.Get("addresses"))
-		userObj.Addresses = addresses
+	if userNestedListChanged(d, "addresses") {
+		userObj.Addresses = expandUserAddresses(d.Get("addresses"))
+		if userObj.Addresses == nil {
+			nullFields = append(nullFields, "Addresses")
+		}
 	}
 
-	if d.HasChange("organizations") {
-		organizations := expandInterfaceObjects(d.Get("organizations"))
-		userObj.Organizations = organizations
+	if userNestedListChanged(d, "organizations") {
+		userObj.Organizations = expandUserOrganizations(d.Get("organizations"))
+		if userObj.Organizations == nil {
+			nullFields = append(nullFields, "Organizations")
+		}
 	}
 
-	if d.HasChange("phones") {
-		phones := expandInterfaceObjects(d.Get("phones"))
-		userObj.Phones = phones
+	if userNestedListChanged(d, "phones") {
+		userObj.Phones = expandUserPhones(d.Get("phones"))
+		if userObj.Phones == nil {
+			nullFields = append(nullFields, "Phones")
+		}
 	}
 
-	if d.HasChange("languages") {
-		languages := expandInterfaceObjects(d.Get("languages"))
-		userObj.Languages = languages
+	if userNestedListChanged(d, "languages") {
+		userObj.Languages = expandUserLanguages(d.Get("languages"))
+		if userObj.Languages == nil {
+			nullFields = append(nullFields, "Languages")
+		}
 	}
 
-	if d.HasChange("posix_accounts") {
-		posixAccounts := expandInterfaceObjects(d.Get("posix_accounts"))
+	if userNestedListChanged(d, "posix_accounts") {
+		posixAccounts, err := expandUserPosixAccounts(d.Get("posix_accounts"))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
 		userObj.PosixAccounts = posixAccounts
+		if posixAccounts == nil {
+			nullFields = append(nullFields, "PosixAccounts")
+		}
 	}
 
-	if d.HasChange("ssh_public_keys") {
-		sshPublicKeys := expandInterfaceObjects(d.Get("ssh_public_keys"))
+	if userNestedListChanged(d, "ssh_public_keys") {
+		sshPublicKeys, err := expandUserSshPublicKeys(d.Get("ssh_public_keys"))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
 		userObj.SshPublicKeys = sshPublicKeys
+		if sshPublicKeys == nil {
+			nullFields = append(nullFields, "SshPublicKeys")
+		}
 	}
 
-	if d.HasChange("websites") {
-		websites := expandInterfaceObjects(d.Get("websites"))
-		userObj.Websites = websites
+	if userNestedListChanged(d, "websites") {
+		userObj.Websites = expandUserWebsites(d.Get("websites"))
+		if userObj.Websites == nil {
+			nullFields = append(nullFields, "Websites")
+		}
 	}
 
-	if d.HasChange("locations") {
-		locations := expandInterfaceObjects(d.Get("locations"))
-		userObj.Locations = locations
+	if userNestedListChanged(d, "locations") {
+		userObj.Locations = expandUserLocations(d.Get("locations"))
+		if userObj.Locations == nil {
+			nullFields = append(nullFields, "Locations")
+		}
 	}
 
-	if d.HasChange("keywords") {
-		keywords := expandInterfaceObjects(d.Get("keywords"))
-		userObj.Keywords = keywords
+	if userNestedListChanged(d, "keywords") {
+		userObj.Keywords = expandUserKeywords(d.Get("keywords"))
+		if userObj.Keywords == nil {
+			nullFields = append(nullFields, "Keywords")
+		}
 	}
 
-	if d.HasChange("ims") {
-		ims := expandInterfaceObjects(d.Get("ims"))
-		userObj.Ims = ims
+	if userNestedListChanged(d, "ims") {
+		userObj.Ims = expandUserIms(d.Get("ims"))
+		if userObj.Ims == nil {
+			nullFields = append(nullFields, "Ims")
+		}
 	}
 
+	userObj.NullFields = nullFields
+
 	if d.HasChange("custom_schemas") {
 		if len(d.Get("custom_schemas").([]interface{})) > 0 {
 			diags = validateCustomSchemas(d, client)
@@ -1538,6 +1828,13 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 			}
 
 			_, err := aliasesService.Insert(d.Id(), &aliasObj).Do()
+			if isApiErrorWithCode(err, 409) {
+				// adopted users may already have the alias, which is only a conflict if it belongs to another user
+				aliasUser, getErr := usersService.Get(alias).Do()
+				if getErr == nil && aliasUser.Id == d.Id() {
+					continue
+				}
+			}
 			if err != nil {
 				return diag.FromErr(err)
 			}
@@ -1563,7 +1860,7 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 	}
 
 	if &userObj != new(directory.User) {
-		_, err := usersService.Update(d.Id(), &userObj).Do()
+		_, err := updateUser(d, usersService, d.Id(), &userObj)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -1596,22 +1893,15 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 		return fmt.Errorf("timed out while waiting for %s to be updated", cc.resourceType)
 	})
 
-	if d.HasChange("primary_email") && d.IsNewResource() == false {
-		old, _ := d.GetChange("primary_email")
-		oldPrimary := old.(string)
-
-		// Remove old primary pleeeeease
-
-		aliasesService, diags := GetUserAliasService(usersService)
-		if diags.HasError() {
-			return diags
-		}
-
-		err := aliasesService.Delete(d.Id(), oldPrimary).Do()
-		if err != nil {
-			return diag.FromErr(err)
-		}
+	// previous emails that are now configured as aliases are managed in aliases instead. The planned
+	// previous emails are unknown, they are in the state, unless the rename above has already set them
+	previous, _ := d.GetChange("previous_email_aliases")
+	if d.HasChange("primary_email") {
+		previous = d.Get("previous_email_aliases")
 	}
+	previousEmails, _ := withoutPreviousEmails(listOfInterfacestoStrings(previous.([]interface{})),
+		listOfInterfacestoStrings(d.Get("aliases").([]interface{})))
+	d.Set("previous_email_aliases", previousEmails)
 
 	if err != nil {
 		return diag.FromErr(err)
@@ -1622,12 +1912,22 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 	if d.HasChange("suspended") {
 		log.Printf("[DEBUG] Applying suspension change for User %q as a separate update", d.Id())
 
+		// the user is signed out before being suspended, so that the user is not left suspended
+		// but still signed in when signing out fails, and the next apply retries both
+		if isUserBeingSuspended(d) && d.Get("sign_out_on_suspend").(bool) {
+			diags = signOutSuspendedUser(d, directoryService, usersService)
+			if diags.HasError() {
+				d.Set("suspended", false)
+				return diags
+			}
+		}
+
 		suspendObj := directory.User{
 			Suspended:       d.Get("suspended").(bool),
 			ForceSendFields: []string{"Suspended"},
 		}
 
-		_, err := usersService.Update(d.Id(), &suspendObj).Do()
+		_, err := updateUser(d, usersService, d.Id(), &suspendObj)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -1682,6 +1982,44 @@ func resourceUserDelete(ctx context.Context, d *schema.ResourceData, meta interf
 		return diags
 	}
 
+	deletionPolicy := d.Get("deletion_policy").(string)
+	if deletionPolicy != "ABANDON" {
+		if err := client.userProtection.checkLiveUser(usersService, strings.ToLower(deletionPolicy), d.Id()); err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err := client.deletionGuard.reserveDeletion("user", primaryEmail); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	switch deletionPolicy {
+	case "ABANDON":
+		log.Printf("[DEBUG] Abandoning User %q: %#v, removing it from state only", d.Id(), primaryEmail)
+
+		return diags
+	case "SUSPEND", "ARCHIVE":
+		log.Printf("[DEBUG] Applying deletion policy %s to User %q: %#v", deletionPolicy, d.Id(), primaryEmail)
+
+		userObj := directory.User{
+			Suspended:       deletionPolicy == "SUSPEND",
+			Archived:        deletionPolicy == "ARCHIVE",
+			ForceSendFields: []string{"Suspended"},
+		}
+		if deletionPolicy == "ARCHIVE" {
+			userObj.ForceSendFields = []string{"Archived"}
+		}
+
+		_, err := usersService.Update(d.Id(), &userObj).Do()
+		if err != nil {
+			return handleNotFoundError(err, d, primaryEmail)
+		}
+
+		log.Printf("[DEBUG] Finished applying deletion policy %s to User %q: %#v", deletionPolicy, d.Id(), primaryEmail)
+
+		return diags
+	}
+
 	if len(d.Get("on_delete_data_transfer").([]interface{})) > 0 {
 		transferInfo := expandInterfaceObjects(d.Get("on_delete_data_transfer"))
 
@@ -1714,7 +2052,7 @@ func resourceUserDelete(ctx context.Context, d *schema.ResourceData, meta interf
 				return diags
 			}
 
-			transferApplications, transferApplicationsErr := transferApplicationsService.List().Do()
+			transferApplications, transferApplicationsErr := listTransferApplications(ctx, transferApplicationsService)
 			if transferApplicationsErr != nil {
 				return diag.FromErr(transferApplicationsErr)
 			}
@@ -1725,42 +2063,36 @@ func resourceUserDelete(ctx context.Context, d *schema.ResourceData, meta interf
 				ApplicationDataTransfers: []*datatransfer.ApplicationDataTransfer{},
 			}
 
-			for _, transferApplication := range transferApplications.Applications {
-				if driveAndDocsTransfer && transferApplication.Name == "Drive and Docs" {
-					transferObject.ApplicationDataTransfers = append(transferObject.ApplicationDataTransfers, &datatransfer.ApplicationDataTransfer{
-						ApplicationId: transferApplication.Id,
-						ApplicationTransferParams: []*datatransfer.ApplicationTransferParam{
-							{
-								Key:   "PRIVACY_LEVEL",
-								Value: []string{driveAndDocsPrivacyLevel},
-							},
-						},
-					})
+			requestedTransfers := []struct {
+				applicationName string
+				transfer        bool
+				paramKey        string
+				paramValue      string
+			}{
+				{"Drive and Docs", driveAndDocsTransfer, "PRIVACY_LEVEL", driveAndDocsPrivacyLevel},
+				{"Calendar", calendarTransfer, "RELEASE_RESOURCES", calendarReleaseResources},
+				{"Looker Studio", lookerStudioTransfer, "PRIVACY_LEVEL", lookerStudioPrivacyLevel},
+			}
+
+			for _, requested := range requestedTransfers {
+				if !requested.transfer {
+					continue
 				}
 
-				if calendarTransfer && transferApplication.Name == "Calendar" {
-					transferObject.ApplicationDataTransfers = append(transferObject.ApplicationDataTransfers, &datatransfer.ApplicationDataTransfer{
-						ApplicationId: transferApplication.Id,
-						ApplicationTransferParams: []*datatransfer.ApplicationTransferParam{
-							{
-								Key:   "RELEASE_RESOURCES",
-								Value: []string{calendarReleaseResources},
-							},
-						},
-					})
+				transferApplication := findTransferApplicationByName(transferApplications, requested.applicationName)
+				if transferApplication == nil {
+					return diag.Errorf("error transferring data of user %s, application %q not found", primaryEmail, requested.applicationName)
 				}
 
-				if lookerStudioTransfer && transferApplication.Name == "Looker Studio" {
-					transferObject.ApplicationDataTransfers = append(transferObject.ApplicationDataTransfers, &datatransfer.ApplicationDataTransfer{
-						ApplicationId: transferApplication.Id,
-						ApplicationTransferParams: []*datatransfer.ApplicationTransferParam{
-							{
-								Key:   "PRIVACY_LEVEL",
-								Value: []string{lookerStudioPrivacyLevel},
-							},
+				transferObject.ApplicationDataTransfers = append(transferObject.ApplicationDataTransfers, &datatransfer.ApplicationDataTransfer{
+					ApplicationId: transferApplication.Id,
+					ApplicationTransferParams: []*datatransfer.ApplicationTransferParam{
+						{
+							Key:   requested.paramKey,
+							Value: []string{requested.paramValue},
 						},
-					})
-				}
+					},
+				})
 			}
 
 			transfers, diags := GetTransfersService(transfersService)
@@ -1823,26 +2155,950 @@ func resourceUserDelete(ctx context.Context, d *schema.ResourceData, meta interf
 	return diags
 }
 
-// Expand functions
-
-func expandName(v interface{}) *directory.UserName {
-	name := v.([]interface{})
-
-	if len(name) == 0 {
-		return nil
+func resourceUserCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if err := validateCustomSchemasDiff(diff, meta); err != nil {
+		return err
 	}
 
-	nameObj := directory.UserName{
-		FamilyName: name[0].(map[string]interface{})["family_name"].(string),
-		GivenName:  name[0].(map[string]interface{})["given_name"].(string),
+	if err := checkUserSuspensionProtected(diff, meta); err != nil {
+		return err
 	}
-	return &nameObj
-}
 
-// Flatten functions
+	// users created with a generated password must change it at their first login, adopted
+	// users keep their password, so the value is only known once the user has been created
+	if diff.Id() == "" && len(diff.Get("generated_password").([]interface{})) > 0 {
+		if rawConfig := diff.GetRawConfig(); !rawConfig.IsNull() {
+			if v := rawConfig.GetAttr("change_password_at_next_login"); v.IsKnown() && !v.IsNull() && v.False() {
+				return fmt.Errorf("change_password_at_next_login can't be false when using generated_password")
+			}
+		}
 
-func flattenName(nameObj *directory.UserName) interface{} {
-	name := []map[string]interface{}{}
+		if !diff.Get("adopt_existing").(bool) {
+			if err := diff.SetNew("change_password_at_next_login", true); err != nil {
+				return err
+			}
+		}
+	}
+
+	// renames may keep the old primary email as an alias, and configured aliases are no longer previous emails
+	if diff.Id() != "" && (diff.HasChange("primary_email") || diff.HasChange("aliases")) {
+		if err := diff.SetNewComputed("previous_email_aliases"); err != nil {
+			return err
+		}
+	}
+
+	// the outcome of signing out the user is only known after the suspension is applied
+	if diff.Id() != "" && diff.Get("sign_out_on_suspend").(bool) && diff.HasChange("suspended") {
+		old, new := diff.GetChange("suspended")
+		if !old.(bool) && new.(bool) {
+			if err := diff.SetNewComputed("last_sign_out_time"); err != nil {
+				return err
+			}
+
+			return diff.SetNewComputed("last_revoked_client_ids")
+		}
+	}
+
+	return nil
+}
+
+// userNestedSetFields are the nested lists of a user that are modeled as sets, so that the order returned
+// by the API does not cause a diff
+var userNestedSetFields = []string{"emails", "external_ids", "relations", "addresses", "organizations", "phones",
+	"languages", "posix_accounts", "ssh_public_keys", "websites", "locations", "keywords", "ims"}
+
+// userNestedSetHash hashes the given keys of a nested list entry. Unset and zero values hash the same,
+// as the API omits them from the response.
+func userNestedSetHash(keys ...string) schema.SchemaSetFunc {
+	return func(v interface{}) int {
+		m := v.(map[string]interface{})
+
+		var buf bytes.Buffer
+		for _, k := range keys {
+			val := fmt.Sprint(m[k])
+			// numbers returned by the API are decoded as float64
+			if f, ok := m[k].(float64); ok {
+				val = strconv.FormatFloat(f, 'f', -1, 64)
+			}
+
+			switch val {
+			case "<nil>", "false", "0":
+			default:
+				buf.WriteString(val)
+			}
+			buf.WriteString(";")
+		}
+
+		return schema.HashString(buf.String())
+	}
+}
+
+func resourceUserResourceV0(r *schema.Resource) *schema.Resource {
+	s := make(map[string]*schema.Schema, len(r.Schema))
+	for k, v := range r.Schema {
+		s[k] = v
+	}
+
+	for _, k := range userNestedSetFields {
+		v0 := *r.Schema[k]
+		v0.Type = schema.TypeList
+		v0.Set = nil
+		s[k] = &v0
+	}
+
+	return &schema.Resource{Schema: s}
+}
+
+// resourceUserStateUpgradeV0 removes the emails added by Google without a type (the primary email and aliases),
+// which are no longer kept in state. The lists are otherwise stored the same way as sets.
+func resourceUserStateUpgradeV0(_ context.Context, rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+	emails, ok := rawState["emails"].([]interface{})
+	if !ok {
+		return rawState, nil
+	}
+
+	rawState["emails"] = normalizeUserEmails(emails)
+
+	log.Printf("[DEBUG] Upgraded User %v state to version 1", rawState["id"])
+
+	return rawState, nil
+}
+
+// normalizeUserEmails removes the emails without a type, which are added by Google for the
+// primary email and aliases, and cannot be configured
+func normalizeUserEmails(emails []interface{}) []interface{} {
+	result := []interface{}{}
+
+	for _, e := range emails {
+		email, ok := e.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if emailType, _ := email["type"].(string); emailType == "" {
+			continue
+		}
+
+		result = append(result, email)
+	}
+
+	return result
+}
+
+// expandUserPasswordUpdate sets the password and the hash function of the user to update, and returns the fields
+// to force send. New users got their password when created, and adopted users keep theirs, so nothing is set for them
+func expandUserPasswordUpdate(d *schema.ResourceData, userObj *directory.User) ([]string, diag.Diagnostics) {
+	forceSendFields := []string{}
+
+	if d.IsNewResource() {
+		return forceSendFields, nil
+	}
+
+	// a password can't be removed, so clearing password (e.g. when switching to password_wo) sends nothing
+	if d.HasChange("password") && d.Get("password").(string) != "" {
+		userObj.Password = d.Get("password").(string)
+	}
+
+	// password_wo is never stored, so password_version is used to know when it should be sent
+	if d.HasChange("password_version") {
+		password, diags := getUserPassword(d)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		if password != "" {
+			userObj.Password = password
+		}
+	}
+
+	if d.HasChange("hash_function") {
+		userObj.HashFunction = d.Get("hash_function").(string)
+
+		if userObj.HashFunction == "" {
+			forceSendFields = append(forceSendFields, "HashFunction")
+		}
+	}
+
+	return forceSendFields, nil
+}
+
+// isUserPatchMode returns whether the user is updated with users.patch, leaving unconfigured values untouched
+func isUserPatchMode(d *schema.ResourceData) bool {
+	updateMode, _ := d.Get("update_mode").(string)
+	return updateMode == "PATCH"
+}
+
+// updateUser sends the user with users.patch or users.update, depending on update_mode
+func updateUser(d *schema.ResourceData, usersService *directory.UsersService, userKey string, userObj *directory.User) (*directory.User, error) {
+	if isUserPatchMode(d) {
+		return usersService.Patch(userKey, userObj).Do()
+	}
+
+	return usersService.Update(userKey, userObj).Do()
+}
+
+// userNestedListChanged returns whether the nested list should be sent to the API. In PATCH mode,
+// a nested list that is no longer configured is left untouched rather than cleared.
+func userNestedListChanged(d *schema.ResourceData, key string) bool {
+	if !d.HasChange(key) {
+		return false
+	}
+
+	if isUserPatchMode(d) {
+		return d.Get(key).(*schema.Set).Len() > 0
+	}
+
+	return true
+}
+
+// userScalarChanged returns whether the optional string field should be sent to the API. In PATCH mode,
+// a field that is no longer configured is left untouched rather than cleared.
+func userScalarChanged(d *schema.ResourceData, key string) bool {
+	if !d.HasChange(key) {
+		return false
+	}
+
+	if isUserPatchMode(d) {
+		return d.Get(key).(string) != ""
+	}
+
+	return true
+}
+
+// setUserScalar sets the optional string field returned by the API. In PATCH mode, fields that are
+// not managed by Terraform are not set, so that values set outside of Terraform are not reported as drift.
+func setUserScalar(d *schema.ResourceData, key string, v string) {
+	if isUserPatchMode(d) && d.Get(key).(string) == "" {
+		return
+	}
+
+	d.Set(key, v)
+}
+
+// setUserNestedList sets the nested list returned by the API. In PATCH mode, nested lists that are
+// not managed by Terraform are not set, so that values set outside of Terraform are not reported as drift.
+func setUserNestedList(d *schema.ResourceData, key string, v interface{}) {
+	if isUserPatchMode(d) && d.Get(key).(*schema.Set).Len() == 0 {
+		return
+	}
+
+	d.Set(key, v)
+}
+
+// validateCustomSchemasDiff validates custom_schemas against the live schema definitions during plan.
+// Values that are not yet known (e.g. the name of a googleworkspace_schema created in the same apply)
+// are validated when the user is created or updated instead.
+func validateCustomSchemasDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	client, ok := meta.(*apiClient)
+	if !ok || client == nil {
+		return nil
+	}
+
+	if diff.Id() != "" && !diff.HasChange("custom_schemas") {
+		return nil
+	}
+
+	if rawConfig := diff.GetRawConfig(); rawConfig.IsNull() || !rawConfig.GetAttr("custom_schemas").IsWhollyKnown() {
+		return nil
+	}
+
+	customSchemas := diff.Get("custom_schemas").([]interface{})
+	if len(customSchemas) == 0 {
+		return nil
+	}
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return fmt.Errorf("%s", diags[0].Summary)
+	}
+
+	schemaService, diags := GetSchemasService(directoryService)
+	if diags.HasError() {
+		return fmt.Errorf("%s", diags[0].Summary)
+	}
+
+	for i, customSchema := range customSchemas {
+		schemaName := customSchema.(map[string]interface{})["schema_name"].(string)
+
+		schemaDef, err := schemaService.Get(client.Customer, schemaName).Do()
+		// schemas created in the same apply are unknown during plan, and were skipped above
+		if isNotFound(err) {
+			return fmt.Errorf("custom_schemas.%d.schema_name: schema %q not found", i, schemaName)
+		}
+		if err != nil {
+			return fmt.Errorf("custom_schemas.%d.schema_name: error getting schema %s: %s", i, schemaName, err)
+		}
+
+		if err := validateCustomSchemaDefinition(i, customSchema.(map[string]interface{}), schemaDef); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// renameUser changes the primary email of an existing user, and waits until the rename is consistent.
+// The old primary email is either deleted, or kept as an alias
+func renameUser(ctx context.Context, d *schema.ResourceData, usersService *directory.UsersService) diag.Diagnostics {
+	old, new := d.GetChange("primary_email")
+
+	rename := &emailRename{
+		resourceType: "user",
+		id:           d.Id(),
+		oldEmail:     old.(string),
+		newEmail:     new.(string),
+		rename: func() error {
+			_, err := usersService.Update(d.Id(), &directory.User{PrimaryEmail: new.(string)}).Do()
+			return err
+		},
+		resolve: func(email string) (string, string, error) {
+			user, err := usersService.Get(email).Fields("id,primaryEmail").Do()
+			if err != nil {
+				return "", "", err
+			}
+			return user.Id, user.PrimaryEmail, nil
+		},
+		deleteAlias: func(alias string) error {
+			return usersService.Aliases.Delete(d.Id(), alias).Do()
+		},
+	}
+
+	kept, err := rename.apply(ctx, d.Timeout(schema.TimeoutUpdate), d.Get("keep_old_email_as_alias").(bool),
+		listOfInterfacestoStrings(d.Get("aliases").([]interface{})))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// the planned previous emails are unknown, the ones kept by earlier renames are in the state
+	previous, _ := d.GetChange("previous_email_aliases")
+	previousEmails := listOfInterfacestoStrings(previous.([]interface{}))
+	if kept {
+		previousEmails = append(previousEmails, old.(string))
+	}
+	d.Set("previous_email_aliases", previousEmails)
+
+	return nil
+}
+
+// checkUserSuspensionProtected refuses to plan the suspension of an existing user protected
+// by the provider's settings
+func checkUserSuspensionProtected(diff *schema.ResourceDiff, meta interface{}) error {
+	client, ok := meta.(*apiClient)
+	if !ok || client == nil || diff.Id() == "" || !diff.HasChange("suspended") {
+		return nil
+	}
+
+	old, new := diff.GetChange("suspended")
+	if old.(bool) || !new.(bool) {
+		return nil
+	}
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return fmt.Errorf("%s", diags[0].Summary)
+	}
+
+	usersService, diags := GetUsersService(directoryService)
+	if diags.HasError() {
+		return fmt.Errorf("%s", diags[0].Summary)
+	}
+
+	return client.userProtection.checkLiveUser(usersService, "suspend", diff.Id())
+}
+
+// isUserBeingSuspended returns whether an existing user is being suspended in this update
+func isUserBeingSuspended(d *schema.ResourceData) bool {
+	if d.IsNewResource() || !d.HasChange("suspended") {
+		return false
+	}
+
+	old, new := d.GetChange("suspended")
+	return !old.(bool) && new.(bool)
+}
+
+// signOutSuspendedUser signs the user that is being suspended out of all sessions and deletes their
+// OAuth tokens, recording the outcome in state
+func signOutSuspendedUser(d *schema.ResourceData, directoryService *directory.Service, usersService *directory.UsersService) diag.Diagnostics {
+	log.Printf("[DEBUG] Signing out suspended User %q", d.Id())
+
+	err := usersService.SignOut(d.Id()).Do()
+	if isApiErrorWithCode(err, 403) {
+		return diag.Errorf("error signing out user %s, check that the admin.directory.user.security scope is in oauth_scopes: %s", d.Id(), err)
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	tokensService, diags := GetTokensService(directoryService)
+	if diags.HasError() {
+		return diags
+	}
+
+	revokedClientIds, err := revokeUserTokens(tokensService, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("last_sign_out_time", time.Now().UTC().Format(time.RFC3339))
+	d.Set("last_revoked_client_ids", revokedClientIds)
+
+	log.Printf("[DEBUG] Finished signing out suspended User %q, revoked tokens of %d applications", d.Id(), len(revokedClientIds))
+
+	return nil
+}
+
+// findExistingUser returns the user with the given primary email, or nil if it does not exist
+func findExistingUser(usersService *directory.UsersService, primaryEmail string) (*directory.User, error) {
+	user, err := usersService.Get(primaryEmail).Do()
+	if isNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// users can also be retrieved by their aliases, which must not be adopted
+	if !strings.EqualFold(user.PrimaryEmail, primaryEmail) {
+		return nil, fmt.Errorf("cannot adopt user %s, it is an alias of user %s", primaryEmail, user.PrimaryEmail)
+	}
+
+	return user, nil
+}
+
+// userAdoptionConflicts returns the fields of the existing user that are overwritten by the configuration
+func userAdoptionConflicts(existing, userObj *directory.User) []string {
+	conflicts := []string{}
+
+	if userObj.Name != nil && existing.Name != nil &&
+		(userObj.Name.GivenName != existing.Name.GivenName || userObj.Name.FamilyName != existing.Name.FamilyName) {
+		conflicts = append(conflicts, "name")
+	}
+
+	if userObj.OrgUnitPath != "" && !strings.EqualFold(userObj.OrgUnitPath, existing.OrgUnitPath) {
+		conflicts = append(conflicts, "org_unit_path")
+	}
+
+	if userObj.Suspended != existing.Suspended {
+		conflicts = append(conflicts, "suspended")
+	}
+
+	if userObj.Archived != existing.Archived {
+		conflicts = append(conflicts, "archived")
+	}
+
+	if userObj.RecoveryEmail != "" && userObj.RecoveryEmail != existing.RecoveryEmail {
+		conflicts = append(conflicts, "recovery_email")
+	}
+
+	if userObj.RecoveryPhone != "" && userObj.RecoveryPhone != existing.RecoveryPhone {
+		conflicts = append(conflicts, "recovery_phone")
+	}
+
+	return conflicts
+}
+
+// findDeletedUser returns the most recently deleted user with the given primary email,
+// or nil if there is none. Deleted users can only be restored within 20 days.
+func findDeletedUser(ctx context.Context, usersService *directory.UsersService, customer, primaryEmail string) (*directory.User, error) {
+	var deletedUser *directory.User
+
+	// only list the deleted users with this address, the exact match is checked below
+	err := usersService.List().Customer(customer).ShowDeleted("true").Query(fmt.Sprintf("email:%s", primaryEmail)).Pages(ctx, func(resp *directory.Users) error {
+		for _, user := range resp.Users {
+			if !strings.EqualFold(user.PrimaryEmail, primaryEmail) {
+				continue
+			}
+
+			// deletion times are RFC 3339 timestamps, so they can be compared as strings
+			if deletedUser == nil || user.DeletionTime > deletedUser.DeletionTime {
+				deletedUser = user
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return deletedUser, nil
+}
+
+// undeleteUser restores the deleted user into the org unit of userObj, and updates it to match userObj
+func undeleteUser(usersService *directory.UsersService, deletedUser, userObj *directory.User) (*directory.User, error) {
+	orgUnitPath := userObj.OrgUnitPath
+	if orgUnitPath == "" {
+		orgUnitPath = "/"
+	}
+
+	log.Printf("[DEBUG] Undeleting User %q: %#v into org unit %s", deletedUser.Id, deletedUser.PrimaryEmail, orgUnitPath)
+
+	// make sure the restored user is not left suspended or archived from before its deletion
+	userObj.ForceSendFields = append(userObj.ForceSendFields, "Suspended", "Archived")
+
+	err := usersService.Undelete(deletedUser.Id, &directory.UserUndelete{
+		OrgUnitPath: orgUnitPath,
+	}).Do()
+	if err != nil {
+		return nil, err
+	}
+
+	return usersService.Update(deletedUser.Id, userObj).Do()
+}
+
+// getUserPassword returns the configured password, preferring the write-only
+// password_wo attribute, which can only be retrieved from the raw config.
+func getUserPassword(d *schema.ResourceData) (string, diag.Diagnostics) {
+	passwordWo, diags := d.GetRawConfigAt(cty.GetAttrPath("password_wo"))
+	if diags.HasError() {
+		return "", diags
+	}
+
+	if !passwordWo.IsNull() && passwordWo.IsKnown() && passwordWo.Type().Equals(cty.String) {
+		return passwordWo.AsString(), nil
+	}
+
+	return d.Get("password").(string), nil
+}
+
+// generateInitialUserPassword generates a random password for a new user, and returns it along with
+// the value (hashed if hash_function is set) to send to the API.
+func generateInitialUserPassword(d *schema.ResourceData) (string, string, string, diag.Diagnostics) {
+	generated := d.Get("generated_password").([]interface{})[0].(map[string]interface{})
+
+	password, err := generatePassword(generated["length"].(int))
+	if err != nil {
+		return "", "", "", diag.FromErr(err)
+	}
+
+	hashFunction := d.Get("hash_function").(string)
+	if hashFunction == "" {
+		return password, password, "", nil
+	}
+
+	hashed, err := hashPassword(password, hashFunction)
+	if err != nil {
+		return "", "", "", diag.FromErr(err)
+	}
+
+	return password, hashed, hashFunction, nil
+}
+
+// writeGeneratedUserPassword writes the generated password to the configured output file
+func writeGeneratedUserPassword(d *schema.ResourceData, password string) diag.Diagnostics {
+	generated := d.Get("generated_password").([]interface{})[0].(map[string]interface{})
+
+	outputFile, err := homedir.Expand(generated["output_file"].(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := os.WriteFile(outputFile, []byte(password), 0600); err != nil {
+		return diag.FromErr(fmt.Errorf("error writing generated password of user %s to %s: %s", d.Id(), outputFile, err))
+	}
+
+	return nil
+}
+
+const (
+	passwordLowerChars   = "abcdefghijklmnopqrstuvwxyz"
+	passwordUpperChars   = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	passwordNumberChars  = "0123456789"
+	passwordSpecialChars = "!#$%&*()-_=+[]{}<>:?"
+)
+
+// generatePassword returns a random password of the given length, containing
+// at least one character of each class.
+func generatePassword(length int) (string, error) {
+	classes := []string{passwordLowerChars, passwordUpperChars, passwordNumberChars, passwordSpecialChars}
+	allChars := strings.Join(classes, "")
+
+	if length < len(classes) {
+		return "", fmt.Errorf("password length must be at least %d", len(classes))
+	}
+
+	randomChar := func(chars string) (byte, error) {
+		n, err := rand.Int(rand.Reader, big.NewInt(int64(len(chars))))
+		if err != nil {
+			return 0, err
+		}
+		return chars[n.Int64()], nil
+	}
+
+	result := make([]byte, length)
+	for i := range result {
+		chars := allChars
+		if i < len(classes) {
+			chars = classes[i]
+		}
+
+		c, err := randomChar(chars)
+		if err != nil {
+			return "", err
+		}
+		result[i] = c
+	}
+
+	// shuffle, so the guaranteed characters are not always at the start
+	for i := len(result) - 1; i > 0; i-- {
+		n, err := rand.Int(rand.Reader, big.NewInt(int64(i+1)))
+		if err != nil {
+			return "", err
+		}
+		j := n.Int64()
+		result[i], result[j] = result[j], result[i]
+	}
+
+	return string(result), nil
+}
+
+// hashPassword hashes the password with one of the hash functions supported by the API
+func hashPassword(password, hashFunction string) (string, error) {
+	switch strings.ToUpper(hashFunction) {
+	case "SHA-1":
+		sum := sha1.Sum([]byte(password))
+		return hex.EncodeToString(sum[:]), nil
+	case "MD5":
+		sum := md5.Sum([]byte(password))
+		return hex.EncodeToString(sum[:]), nil
+	case "CRYPT":
+		return sha512_crypt.New().Generate([]byte(password), nil)
+	}
+
+	return "", fmt.Errorf("unsupported hash function %q", hashFunction)
+}
+
+// Expand functions
+
+func expandName(v interface{}) *directory.UserName {
+	name := v.([]interface{})
+
+	if len(name) == 0 {
+		return nil
+	}
+
+	nameObj := directory.UserName{
+		FamilyName: name[0].(map[string]interface{})["family_name"].(string),
+		GivenName:  name[0].(map[string]interface{})["given_name"].(string),
+	}
+	return &nameObj
+}
+
+// Nested lists are expanded into the typed API objects, each returns nil when the list is empty so the field is
+// omitted from the request. Unset fields are omitted, and false booleans are not sent.
+
+func expandUserEmails(v interface{}) interface{} {
+	var result []*directory.UserEmail
+
+	for _, raw := range v.(*schema.Set).List() {
+		email := raw.(map[string]interface{})
+		result = append(result, &directory.UserEmail{
+			Address:    email["address"].(string),
+			CustomType: email["custom_type"].(string),
+			Primary:    email["primary"].(bool),
+			Type:       email["type"].(string),
+		})
+	}
+
+	if len(result) == 0 {
+		return nil
+	}
+
+	return result
+}
+
+func expandUserExternalIds(v interface{}) interface{} {
+	var result []*directory.UserExternalId
+
+	for _, raw := range v.(*schema.Set).List() {
+		externalId := raw.(map[string]interface{})
+		result = append(result, &directory.UserExternalId{
+			CustomType: externalId["custom_type"].(string),
+			Type:       externalId["type"].(string),
+			Value:      externalId["value"].(string),
+		})
+	}
+
+	if len(result) == 0 {
+		return nil
+	}
+
+	return result
+}
+
+func expandUserRelations(v interface{}) interface{} {
+	var result []*directory.UserRelation
+
+	for _, raw := range v.(*schema.Set).List() {
+		relation := raw.(map[string]interface{})
+		result = append(result, &directory.UserRelation{
+			CustomType: relation["custom_type"].(string),
+			Type:       relation["type"].(string),
+			Value:      relation["value"].(string),
+		})
+	}
+
+	if len(result) == 0 {
+		return nil
+	}
+
+	return result
+}
+
+func expandUserAddresses(v interface{}) interface{} {
+	var result []*directory.UserAddress
+
+	for _, raw := range v.(*schema.Set).List() {
+		address := raw.(map[string]interface{})
+		result = append(result, &directory.UserAddress{
+			Country:            address["country"].(string),
+			CountryCode:        address["country_code"].(string),
+			CustomType:         address["custom_type"].(string),
+			ExtendedAddress:    address["extended_address"].(string),
+			Formatted:          address["formatted"].(string),
+			Locality:           address["locality"].(string),
+			PoBox:              address["po_box"].(string),
+			PostalCode:         address["postal_code"].(string),
+			Primary:            address["primary"].(bool),
+			Region:             address["region"].(string),
+			SourceIsStructured: address["source_is_structured"].(bool),
+			StreetAddress:      address["street_address"].(string),
+			Type:               address["type"].(string),
+		})
+	}
+
+	if len(result) == 0 {
+		return nil
+	}
+
+	return result
+}
+
+func expandUserOrganizations(v interface{}) interface{} {
+	var result []*directory.UserOrganization
+
+	for _, raw := range v.(*schema.Set).List() {
+		organization := raw.(map[string]interface{})
+		result = append(result, &directory.UserOrganization{
+			CostCenter:         organization["cost_center"].(string),
+			CustomType:         organization["custom_type"].(string),
+			Department:         organization["department"].(string),
+			Description:        organization["description"].(string),
+			Domain:             organization["domain"].(string),
+			FullTimeEquivalent: int64(organization["full_time_equivalent"].(int)),
+			Location:           organization["location"].(string),
+			Name:               organization["name"].(string),
+			Primary:            organization["primary"].(bool),
+			Symbol:             organization["symbol"].(string),
+			Title:              organization["title"].(string),
+			Type:               organization["type"].(string),
+		})
+	}
+
+	if len(result) == 0 {
+		return nil
+	}
+
+	return result
+}
+
+func expandUserPhones(v interface{}) interface{} {
+	var result []*directory.UserPhone
+
+	for _, raw := range v.(*schema.Set).List() {
+		phone := raw.(map[string]interface{})
+		result = append(result, &directory.UserPhone{
+			CustomType: phone["custom_type"].(string),
+			Primary:    phone["primary"].(bool),
+			Type:       phone["type"].(string),
+			Value:      phone["value"].(string),
+		})
+	}
+
+	if len(result) == 0 {
+		return nil
+	}
+
+	return result
+}
+
+func expandUserLanguages(v interface{}) interface{} {
+	var result []*directory.UserLanguage
+
+	for _, raw := range v.(*schema.Set).List() {
+		language := raw.(map[string]interface{})
+		result = append(result, &directory.UserLanguage{
+			CustomLanguage: language["custom_language"].(string),
+			LanguageCode:   language["language_code"].(string),
+			Preference:     language["preference"].(string),
+		})
+	}
+
+	if len(result) == 0 {
+		return nil
+	}
+
+	return result
+}
+
+func expandUserPosixAccounts(v interface{}) (interface{}, error) {
+	var result []*directory.UserPosixAccount
+
+	for _, raw := range v.(*schema.Set).List() {
+		account := raw.(map[string]interface{})
+
+		gid, err := parseOptionalUint(account["gid"].(string))
+		if err != nil {
+			return nil, fmt.Errorf("invalid posix account gid: %s", err)
+		}
+
+		uid, err := parseOptionalUint(account["uid"].(string))
+		if err != nil {
+			return nil, fmt.Errorf("invalid posix account uid: %s", err)
+		}
+
+		result = append(result, &directory.UserPosixAccount{
+			AccountId:           account["account_id"].(string),
+			Gecos:               account["gecos"].(string),
+			Gid:                 gid,
+			HomeDirectory:       account["home_directory"].(string),
+			OperatingSystemType: account["operating_system_type"].(string),
+			Primary:             account["primary"].(bool),
+			Shell:               account["shell"].(string),
+			SystemId:            account["system_id"].(string),
+			Uid:                 uid,
+			Username:            account["username"].(string),
+		})
+	}
+
+	if len(result) == 0 {
+		return nil, nil
+	}
+
+	return result, nil
+}
+
+func expandUserSshPublicKeys(v interface{}) (interface{}, error) {
+	var result []*directory.UserSshPublicKey
+
+	for _, raw := range v.(*schema.Set).List() {
+		key := raw.(map[string]interface{})
+
+		expirationTimeUsec, err := parseOptionalUint(key["expiration_time_usec"].(string))
+		if err != nil {
+			return nil, fmt.Errorf("invalid ssh public key expiration_time_usec: %s", err)
+		}
+
+		result = append(result, &directory.UserSshPublicKey{
+			ExpirationTimeUsec: int64(expirationTimeUsec),
+			Key:                key["key"].(string),
+		})
+	}
+
+	if len(result) == 0 {
+		return nil, nil
+	}
+
+	return result, nil
+}
+
+func expandUserWebsites(v interface{}) interface{} {
+	var result []*directory.UserWebsite
+
+	for _, raw := range v.(*schema.Set).List() {
+		website := raw.(map[string]interface{})
+		result = append(result, &directory.UserWebsite{
+			CustomType: website["custom_type"].(string),
+			Primary:    website["primary"].(bool),
+			Type:       website["type"].(string),
+			Value:      website["value"].(string),
+		})
+	}
+
+	if len(result) == 0 {
+		return nil
+	}
+
+	return result
+}
+
+func expandUserLocations(v interface{}) interface{} {
+	var result []*directory.UserLocation
+
+	for _, raw := range v.(*schema.Set).List() {
+		location := raw.(map[string]interface{})
+		result = append(result, &directory.UserLocation{
+			Area:         location["area"].(string),
+			BuildingId:   location["building_id"].(string),
+			CustomType:   location["custom_type"].(string),
+			DeskCode:     location["desk_code"].(string),
+			FloorName:    location["floor_name"].(string),
+			FloorSection: location["floor_section"].(string),
+			Type:         location["type"].(string),
+		})
+	}
+
+	if len(result) == 0 {
+		return nil
+	}
+
+	return result
+}
+
+func expandUserKeywords(v interface{}) interface{} {
+	var result []*directory.UserKeyword
+
+	for _, raw := range v.(*schema.Set).List() {
+		keyword := raw.(map[string]interface{})
+		result = append(result, &directory.UserKeyword{
+			CustomType: keyword["custom_type"].(string),
+			Type:       keyword["type"].(string),
+			Value:      keyword["value"].(string),
+		})
+	}
+
+	if len(result) == 0 {
+		return nil
+	}
+
+	return result
+}
+
+func expandUserIms(v interface{}) interface{} {
+	var result []*directory.UserIm
+
+	for _, raw := range v.(*schema.Set).List() {
+		im := raw.(map[string]interface{})
+		result = append(result, &directory.UserIm{
+			CustomProtocol: im["custom_protocol"].(string),
+			CustomType:     im["custom_type"].(string),
+			Im:             im["im"].(string),
+			Primary:        im["primary"].(bool),
+			Protocol:       im["protocol"].(string),
+			Type:           im["type"].(string),
+		})
+	}
+
+	if len(result) == 0 {
+		return nil
+	}
+
+	return result
+}
+
+// parseOptionalUint parses the string representation of an unsigned integer, an empty string is 0
+func parseOptionalUint(s string) (uint64, error) {
+	if s == "" {
+		return 0, nil
+	}
+
+	return strconv.ParseUint(s, 10, 64)
+}
+
+// Flatten functions
+
+func flattenName(nameObj *directory.UserName) interface{} {
+	name := []map[string]interface{}{}
 
 	if nameObj != nil {
 		name = append(name, map[string]interface{}{
@@ -1855,14 +3111,282 @@ func flattenName(nameObj *directory.UserName) interface{} {
 	return name
 }
 
+// userNestedLists holds the nested lists of a user, which the API client leaves untyped
+type userNestedLists struct {
+	Emails        []*directory.UserEmail        `json:"emails"`
+	ExternalIds   []*directory.UserExternalId   `json:"externalIds"`
+	Relations     []*directory.UserRelation     `json:"relations"`
+	Addresses     []*directory.UserAddress      `json:"addresses"`
+	Organizations []*directory.UserOrganization `json:"organizations"`
+	Phones        []*directory.UserPhone        `json:"phones"`
+	Languages     []*directory.UserLanguage     `json:"languages"`
+	PosixAccounts []*directory.UserPosixAccount `json:"posixAccounts"`
+	SshPublicKeys []*directory.UserSshPublicKey `json:"sshPublicKeys"`
+	Websites      []*directory.UserWebsite      `json:"websites"`
+	Locations     []*directory.UserLocation     `json:"locations"`
+	Keywords      []*directory.UserKeyword      `json:"keywords"`
+	Ims           []*directory.UserIm           `json:"ims"`
+}
+
+// decodeUserNestedLists decodes the untyped nested lists of a user into their typed API objects
+func decodeUserNestedLists(user *directory.User) (*userNestedLists, error) {
+	b, err := json.Marshal(user)
+	if err != nil {
+		return nil, err
+	}
+
+	nested := &userNestedLists{}
+	if err := json.Unmarshal(b, nested); err != nil {
+		return nil, fmt.Errorf("error decoding nested lists of user %s: %s", user.PrimaryEmail, err)
+	}
+
+	return nested, nil
+}
+
+// flattenUserEmails flattens the emails. Unless includeUntyped is set, the emails added by Google for the
+// primary email and aliases, which have no type and cannot be configured, are left out.
+func flattenUserEmails(emails []*directory.UserEmail, includeUntyped bool) []interface{} {
+	result := []interface{}{}
+
+	for _, email := range emails {
+		if email.Type == "" && !includeUntyped {
+			continue
+		}
+
+		result = append(result, map[string]interface{}{
+			"address":     email.Address,
+			"custom_type": email.CustomType,
+			"primary":     email.Primary,
+			"type":        email.Type,
+		})
+	}
+
+	return result
+}
+
+func flattenUserExternalIds(externalIds []*directory.UserExternalId) []interface{} {
+	result := []interface{}{}
+
+	for _, externalId := range externalIds {
+		result = append(result, map[string]interface{}{
+			"custom_type": externalId.CustomType,
+			"type":        externalId.Type,
+			"value":       externalId.Value,
+		})
+	}
+
+	return result
+}
+
+func flattenUserRelations(relations []*directory.UserRelation) []interface{} {
+	result := []interface{}{}
+
+	for _, relation := range relations {
+		result = append(result, map[string]interface{}{
+			"custom_type": relation.CustomType,
+			"type":        relation.Type,
+			"value":       relation.Value,
+		})
+	}
+
+	return result
+}
+
+func flattenUserAddresses(addresses []*directory.UserAddress) []interface{} {
+	result := []interface{}{}
+
+	for _, address := range addresses {
+		result = append(result, map[string]interface{}{
+			"country":              address.Country,
+			"country_code":         address.CountryCode,
+			"custom_type":          address.CustomType,
+			"extended_address":     address.ExtendedAddress,
+			"formatted":            address.Formatted,
+			"locality":             address.Locality,
+			"po_box":               address.PoBox,
+			"postal_code":          address.PostalCode,
+			"primary":              address.Primary,
+			"region":               address.Region,
+			"source_is_structured": address.SourceIsStructured,
+			"street_address":       address.StreetAddress,
+			"type":                 address.Type,
+		})
+	}
+
+	return result
+}
+
+func flattenUserOrganizations(organizations []*directory.UserOrganization) []interface{} {
+	result := []interface{}{}
+
+	for _, organization := range organizations {
+		result = append(result, map[string]interface{}{
+			"cost_center":          organization.CostCenter,
+			"custom_type":          organization.CustomType,
+			"department":           organization.Department,
+			"description":          organization.Description,
+			"domain":               organization.Domain,
+			"full_time_equivalent": int(organization.FullTimeEquivalent),
+			"location":             organization.Location,
+			"name":                 organization.Name,
+			"primary":              organization.Primary,
+			"symbol":               organization.Symbol,
+			"title":                organization.Title,
+			"type":                 organization.Type,
+		})
+	}
+
+	return result
+}
+
+func flattenUserPhones(phones []*directory.UserPhone) []interface{} {
+	result := []interface{}{}
+
+	for _, phone := range phones {
+		result = append(result, map[string]interface{}{
+			"custom_type": phone.CustomType,
+			"primary":     phone.Primary,
+			"type":        phone.Type,
+			"value":       phone.Value,
+		})
+	}
+
+	return result
+}
+
+func flattenUserLanguages(languages []*directory.UserLanguage) []interface{} {
+	result := []interface{}{}
+
+	for _, language := range languages {
+		result = append(result, map[string]interface{}{
+			"custom_language": language.CustomLanguage,
+			"language_code":   language.LanguageCode,
+			"preference":      language.Preference,
+		})
+	}
+
+	return result
+}
+
+func flattenUserPosixAccounts(accounts []*directory.UserPosixAccount) []interface{} {
+	result := []interface{}{}
+
+	for _, account := range accounts {
+		result = append(result, map[string]interface{}{
+			"account_id":            account.AccountId,
+			"gecos":                 account.Gecos,
+			"gid":                   formatOptionalUint(account.Gid),
+			"home_directory":        account.HomeDirectory,
+			"operating_system_type": account.OperatingSystemType,
+			"primary":               account.Primary,
+			"shell":                 account.Shell,
+			"system_id":             account.SystemId,
+			"uid":                   formatOptionalUint(account.Uid),
+			"username":              account.Username,
+		})
+	}
+
+	return result
+}
+
+func flattenUserSshPublicKeys(keys []*directory.UserSshPublicKey) []interface{} {
+	result := []interface{}{}
+
+	for _, key := range keys {
+		result = append(result, map[string]interface{}{
+			"expiration_time_usec": formatOptionalUint(uint64(key.ExpirationTimeUsec)),
+			"fingerprint":          key.Fingerprint,
+			"key":                  key.Key,
+		})
+	}
+
+	return result
+}
+
+func flattenUserWebsites(websites []*directory.UserWebsite) []interface{} {
+	result := []interface{}{}
+
+	for _, website := range websites {
+		result = append(result, map[string]interface{}{
+			"custom_type": website.CustomType,
+			"primary":     website.Primary,
+			"type":        website.Type,
+			"value":       website.Value,
+		})
+	}
+
+	return result
+}
+
+func flattenUserLocations(locations []*directory.UserLocation) []interface{} {
+	result := []interface{}{}
+
+	for _, location := range locations {
+		result = append(result, map[string]interface{}{
+			"area":          location.Area,
+			"building_id":   location.BuildingId,
+			"custom_type":   location.CustomType,
+			"desk_code":     location.DeskCode,
+			"floor_name":    location.FloorName,
+			"floor_section": location.FloorSection,
+			"type":          location.Type,
+		})
+	}
+
+	return result
+}
+
+func flattenUserKeywords(keywords []*directory.UserKeyword) []interface{} {
+	result := []interface{}{}
+
+	for _, keyword := range keywords {
+		result = append(result, map[string]interface{}{
+			"custom_type": keyword.CustomType,
+			"type":        keyword.Type,
+			"value":       keyword.Value,
+		})
+	}
+
+	return result
+}
+
+func flattenUserIms(ims []*directory.UserIm) []interface{} {
+	result := []interface{}{}
+
+	for _, im := range ims {
+		result = append(result, map[string]interface{}{
+			"custom_protocol": im.CustomProtocol,
+			"custom_type":     im.CustomType,
+			"im":              im.Im,
+			"primary":         im.Primary,
+			"protocol":        im.Protocol,
+			"type":            im.Type,
+		})
+	}
+
+	return result
+}
+
+// formatOptionalUint formats an unsigned integer, 0 being unset is formatted as an empty string
+func formatOptionalUint(i uint64) string {
+	if i == 0 {
+		return ""
+	}
+
+	return strconv.FormatUint(i, 10)
+}
+
 // Helper functions
 
 // Custom Schemas
 
 func validateCustomSchemas(d *schema.ResourceData, client *apiClient) diag.Diagnostics {
-	var diags diag.Diagnostics
+	return validateCustomSchemaValues(d.Get("custom_schemas").([]interface{}), client)
+}
 
-	new := d.Get("custom_schemas")
+// validateCustomSchemaValues validates a list of schema_name/schema_values maps against the schema definitions
+func validateCustomSchemaValues(customSchemas []interface{}, client *apiClient) diag.Diagnostics {
+	var diags diag.Diagnostics
 
 	directoryService, diags := client.NewDirectoryService()
 	if diags.HasError() {
@@ -1875,7 +3399,7 @@ func validateCustomSchemas(d *schema.ResourceData, client *apiClient) diag.Diagn
 	}
 
 	// Validate config against schemas
-	for _, customSchema := range new.([]interface{}) {
+	for i, customSchema := range customSchemas {
 		schemaName := customSchema.(map[string]interface{})["schema_name"].(string)
 
 		schemaDef, err := schemaService.Get(client.Customer, schemaName).Do()
@@ -1890,46 +3414,68 @@ func validateCustomSchemas(d *schema.ResourceData, client *apiClient) diag.Diagn
 			})
 		}
 
-		schemaFieldMap := map[string]*directory.SchemaFieldSpec{}
-		for _, schemaField := range schemaDef.Fields {
-			schemaFieldMap[schemaField.FieldName] = schemaField
+		if err := validateCustomSchemaDefinition(i, customSchema.(map[string]interface{}), schemaDef); err != nil {
+			return append(diags, diag.Diagnostic{
+				Summary:       err.Error(),
+				Severity:      diag.Error,
+				AttributePath: cty.GetAttrPath("custom_schemas").IndexInt(i).GetAttr("schema_values"),
+			})
 		}
+	}
 
-		customSchemaDef := customSchema.(map[string]interface{})["schema_values"].(map[string]interface{})
+	return nil
+}
 
-		for csKey, csJsonVal := range customSchemaDef {
-			if _, ok := schemaFieldMap[csKey]; !ok {
-				return append(diags, diag.Diagnostic{
-					Summary:  fmt.Sprintf("field name (%s) is not found in this schema definition (%s)", csKey, schemaName),
-					Severity: diag.Error,
-				})
-			}
+// validateCustomSchemaDefinition validates the values of the custom_schemas entry at the given index against
+// the schema definition: field names, field types, the shape of multi-valued fields and numeric indexing bounds.
+func validateCustomSchemaDefinition(index int, customSchema map[string]interface{}, schemaDef *directory.Schema) error {
+	schemaName := customSchema["schema_name"].(string)
 
-			var csVal interface{}
-			err := json.Unmarshal([]byte(csJsonVal.(string)), &csVal)
-			if err != nil {
-				return diag.FromErr(err)
-			}
+	schemaFieldMap := map[string]*directory.SchemaFieldSpec{}
+	for _, schemaField := range schemaDef.Fields {
+		schemaFieldMap[schemaField.FieldName] = schemaField
+	}
 
-			if schemaFieldMap[csKey].MultiValued {
-				if reflect.ValueOf(csVal).Kind() != reflect.Slice {
-					return append(diags, diag.Diagnostic{
-						Summary:  fmt.Sprintf("field %s is multi-values and should be a list (%+v)", csKey, csVal),
-						Severity: diag.Error,
-					})
-				}
+	customSchemaDef := customSchema["schema_values"].(map[string]interface{})
 
-				if len(csVal.([]interface{})) > 0 {
-					csVal = csVal.([]interface{})[0]
-				}
+	// sort the field names, so the same error is always returned first
+	csKeys := make([]string, 0, len(customSchemaDef))
+	for csKey := range customSchemaDef {
+		csKeys = append(csKeys, csKey)
+	}
+	sort.Strings(csKeys)
+
+	for _, csKey := range csKeys {
+		path := fmt.Sprintf("custom_schemas.%d.schema_values.%s", index, csKey)
+
+		schemaField, ok := schemaFieldMap[csKey]
+		if !ok {
+			return fmt.Errorf("%s: field name (%s) is not found in this schema definition (%s)", path, csKey, schemaName)
+		}
+
+		var csVal interface{}
+		if err := json.Unmarshal([]byte(customSchemaDef[csKey].(string)), &csVal); err != nil {
+			return fmt.Errorf("%s: %s", path, err)
+		}
+
+		csVals := []interface{}{csVal}
+		if schemaField.MultiValued {
+			list, ok := csVal.([]interface{})
+			if !ok {
+				return fmt.Errorf("%s: field %s is multi-valued and should be a list (%+v)", path, csKey, csVal)
+			}
+			csVals = list
+		} else if _, ok := csVal.([]interface{}); ok {
+			return fmt.Errorf("%s: field %s is not multi-valued and should not be a list (%+v)", path, csKey, csVal)
+		}
+
+		for _, v := range csVals {
+			if !validateFieldValueType(schemaField.FieldType, v) {
+				return fmt.Errorf("%s: value provided for %s is of incorrect type (expected type: %s)", path, csKey, schemaField.FieldType)
 			}
 
-			validType := validateFieldValueType(schemaFieldMap[csKey].FieldType, csVal)
-			if !validType {
-				return append(diags, diag.Diagnostic{
-					Summary:  fmt.Sprintf("value provided for %s is of incorrect type (expected type: %s)", csKey, schemaFieldMap[csKey].FieldType),
-					Severity: diag.Error,
-				})
+			if err := validateFieldValueBounds(schemaField, v); err != nil {
+				return fmt.Errorf("%s: %s", path, err)
 			}
 		}
 	}
@@ -1937,6 +3483,30 @@ func validateCustomSchemas(d *schema.ResourceData, client *apiClient) diag.Diagn
 	return nil
 }
 
+// validateFieldValueBounds validates that numeric values are within the numeric indexing spec. The minimum
+// and maximum are only checked when they are set, the API omits them when they are zero.
+func validateFieldValueBounds(schemaField *directory.SchemaFieldSpec, fieldValue interface{}) error {
+	spec := schemaField.NumericIndexingSpec
+	if spec == nil {
+		return nil
+	}
+
+	value, ok := fieldValue.(float64)
+	if !ok {
+		return nil
+	}
+
+	if spec.MinValue != 0 && value < spec.MinValue {
+		return fmt.Errorf("value %v for %s is below the numeric indexing minimum %v", value, schemaField.FieldName, spec.MinValue)
+	}
+
+	if spec.MaxValue != 0 && value > spec.MaxValue {
+		return fmt.Errorf("value %v for %s is above the numeric indexing maximum %v", value, schemaField.FieldName, spec.MaxValue)
+	}
+
+	return nil
+}
+
 // This will take a value and validate whether the type is correct
 func validateFieldValueType(fieldType string, fieldValue interface{}) bool {
 	valid := false
@@ -1946,16 +3516,16 @@ func validateFieldValueType(fieldType string, fieldValue interface{}) bool {
 		valid = reflect.ValueOf(fieldValue).Kind() == reflect.Bool
 	case "DATE":
 		// ISO 8601 format
-		_, err := time.Parse("2006-01-02", fieldValue.(string))
-		if err == nil {
-			valid = true
+		if date, ok := fieldValue.(string); ok {
+			_, err := time.Parse("2006-01-02", date)
+			valid = err == nil
 		}
 	case "DOUBLE":
 		valid = reflect.ValueOf(fieldValue).Kind() == reflect.Float64
 	case "EMAIL":
-		_, err := mail.ParseAddress(fieldValue.(string))
-		if err == nil {
-			valid = true
+		if email, ok := fieldValue.(string); ok {
+			_, err := mail.ParseAddress(email)
+			valid = err == nil
 		}
 	case "INT64":
 		// this is unmarshalled as a float, check that it's an int
@@ -2127,3 +3697,27 @@ func flattenCustomSchemas(schemaAttrObj interface{}, client *apiClient) ([]map[s
 
 	return customSchemas, nil
 }
+
+// resourceUserImport resolves the user's primary email or one of its aliases to the immutable user ID
+func resourceUserImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*apiClient)
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return nil, fmt.Errorf("%s", diags[0].Summary)
+	}
+
+	usersService, diags := GetUsersService(directoryService)
+	if diags.HasError() {
+		return nil, fmt.Errorf("%s", diags[0].Summary)
+	}
+
+	user, err := usersService.Get(d.Id()).Fields("id").Do()
+	if err != nil {
+		return nil, fmt.Errorf("error importing user %q: %s", d.Id(), err)
+	}
+
+	d.SetId(user.Id)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,246 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	directory "google.golang.org/api/admin/directory/v1"
+)
+
+func resourceUserAlias() *schema.Resource {
+	return &schema.Resource{
+		Description: "User Alias resource manages a single alias of a Google Workspace User. It can be used " +
+			"alongside `googleworkspace_user`, as long as the user resource does not manage the `aliases` " +
+			"attribute (e.g. with `lifecycle { ignore_changes = [aliases] }`). User Alias resides under the " +
+			"`https://www.googleapis.com/auth/admin.directory.user.alias` client scope.",
+
+		CreateContext: resourceUserAliasCreate,
+		ReadContext:   resourceUserAliasRead,
+		DeleteContext: resourceUserAliasDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceUserAliasImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The ID of this resource, in the format `users/{user_id}/aliases/{alias}`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"user_id": {
+				Description: "The user's primary email address, or unique user ID.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"alias": {
+				Description: "The alias email address.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"primary_email": {
+				Description: "The primary email address of the user the alias belongs to.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceUserAliasCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return diags
+	}
+
+	usersService, diags := GetUsersService(directoryService)
+	if diags.HasError() {
+		return diags
+	}
+
+	aliasesService, diags := GetUserAliasService(usersService)
+	if diags.HasError() {
+		return diags
+	}
+
+	userId := d.Get("user_id").(string)
+	alias := d.Get("alias").(string)
+	log.Printf("[DEBUG] Creating User Alias %q for user %s", alias, userId)
+
+	_, err := aliasesService.Insert(userId, &directory.Alias{
+		Alias: alias,
+	}).Do()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("users/%s/aliases/%s", userId, alias))
+
+	// INSERT returns the alias straight away, however, it is eventually consistent,
+	// wait until the alias is included when listing the aliases of the user
+	err = retryTimeDuration(ctx, d.Timeout(schema.TimeoutCreate), func() error {
+		found, _, retryErr := findUserAlias(aliasesService, userId, alias)
+		if retryErr != nil && !isNotFound(retryErr) {
+			return fmt.Errorf("unexpected error during retries of user alias: %s", retryErr)
+		}
+
+		if !found {
+			return fmt.Errorf("timed out while waiting for user alias to be inserted")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Finished creating User Alias %q for user %s", alias, userId)
+
+	return resourceUserAliasRead(ctx, d, meta)
+}
+
+func resourceUserAliasRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return diags
+	}
+
+	usersService, diags := GetUsersService(directoryService)
+	if diags.HasError() {
+		return diags
+	}
+
+	aliasesService, diags := GetUserAliasService(usersService)
+	if diags.HasError() {
+		return diags
+	}
+
+	userId := d.Get("user_id").(string)
+	alias := d.Get("alias").(string)
+	log.Printf("[DEBUG] Getting User Alias %q for user %s", alias, userId)
+
+	found, primaryEmail, err := findUserAlias(aliasesService, userId, alias)
+	if err != nil {
+		return handleNotFoundError(err, d, d.Id())
+	}
+
+	if !found {
+		log.Printf("[WARN] Removing User Alias %q because it's gone", d.Id())
+		d.SetId("")
+
+		return nil
+	}
+
+	d.Set("primary_email", primaryEmail)
+
+	log.Printf("[DEBUG] Finished getting User Alias %q for user %s", alias, userId)
+
+	return diags
+}
+
+func resourceUserAliasDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return diags
+	}
+
+	usersService, diags := GetUsersService(directoryService)
+	if diags.HasError() {
+		return diags
+	}
+
+	aliasesService, diags := GetUserAliasService(usersService)
+	if diags.HasError() {
+		return diags
+	}
+
+	userId := d.Get("user_id").(string)
+	alias := d.Get("alias").(string)
+	log.Printf("[DEBUG] Deleting User Alias %q for user %s", alias, userId)
+
+	err := aliasesService.Delete(userId, alias).Do()
+	if err != nil {
+		return handleNotFoundError(err, d, d.Id())
+	}
+
+	// wait until the alias is no longer listed, so it can be re-used straight away
+	err = retryTimeDuration(ctx, d.Timeout(schema.TimeoutDelete), func() error {
+		found, _, retryErr := findUserAlias(aliasesService, userId, alias)
+		if isNotFound(retryErr) {
+			return nil
+		}
+
+		if retryErr != nil {
+			return fmt.Errorf("unexpected error during retries of user alias: %s", retryErr)
+		}
+
+		if found {
+			return fmt.Errorf("timed out while waiting for user alias to be deleted")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Finished deleting User Alias %q for user %s", alias, userId)
+
+	return diags
+}
+
+func resourceUserAliasImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 4 || parts[0] != "users" || parts[1] == "" || parts[2] != "aliases" || parts[3] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected users/{user_id}/aliases/{alias}", d.Id())
+	}
+
+	d.Set("user_id", parts[1])
+	d.Set("alias", parts[3])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// findUserAlias lists the aliases of the user and returns whether the alias is one of them,
+// along with the primary email of the user
+func findUserAlias(aliasesService *directory.UsersAliasesService, userId, alias string) (bool, string, error) {
+	aliases, err := aliasesService.List(userId).Do()
+	if err != nil {
+		return false, "", err
+	}
+
+	for _, a := range aliases.Aliases {
+		aliasObj, ok := a.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if strings.EqualFold(fmt.Sprint(aliasObj["alias"]), alias) {
+			return true, fmt.Sprint(aliasObj["primaryEmail"]), nil
+		}
+	}
+
+	return false, "", nil
+}
@@ -0,0 +1,199 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	directory "google.golang.org/api/admin/directory/v1"
+)
+
+func resourceUserCredentialsRevocation() *schema.Resource {
+	return &schema.Resource{
+		Description: "User Credentials Revocation resource revokes the credentials of a user when it is created, " +
+			"e.g. as part of offboarding. It can delete all OAuth tokens granted to third-party applications, delete " +
+			"all application-specific passwords (ASPs), and turn off 2-Step Verification. Changing any argument, " +
+			"including `triggers`, revokes the credentials again. Destroying this resource only removes it from the " +
+			"state. User Credentials Revocation resides under the " +
+			"`https://www.googleapis.com/auth/admin.directory.user.security` client scope.",
+
+		CreateContext: resourceUserCredentialsRevocationCreate,
+		ReadContext:   resourceUserCredentialsRevocationRead,
+		DeleteContext: resourceUserCredentialsRevocationDelete,
+
+		Schema: map[string]*schema.Schema{
+			"user_id": {
+				Description: "The user's primary email address, alias email address, or unique user ID.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"revoke_oauth_tokens": {
+				Description: "Whether to delete all OAuth tokens the user granted to third-party applications.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     true,
+			},
+			"revoke_asps": {
+				Description: "Whether to delete all application-specific passwords of the user.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     true,
+			},
+			"turn_off_two_step_verification": {
+				Description: "Whether to turn off 2-Step Verification for the user, if they are enrolled.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+			},
+			"triggers": {
+				Description: "Arbitrary map of values that, when changed, will revoke the credentials again.",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"revoked_client_ids": {
+				Description: "The Client IDs of the applications whose OAuth tokens were deleted.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"revoked_asp_ids": {
+				Description: "The IDs of the application-specific passwords that were deleted.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+		},
+	}
+}
+
+func resourceUserCredentialsRevocationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return diags
+	}
+
+	userId := d.Get("user_id").(string)
+	log.Printf("[DEBUG] Revoking credentials of user %s", userId)
+
+	revokedClientIds := []string{}
+	if d.Get("revoke_oauth_tokens").(bool) {
+		tokensService, diags := GetTokensService(directoryService)
+		if diags.HasError() {
+			return diags
+		}
+
+		var err error
+		revokedClientIds, err = revokeUserTokens(tokensService, userId)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	revokedAspIds := []int64{}
+	if d.Get("revoke_asps").(bool) {
+		aspsService, diags := GetAspsService(directoryService)
+		if diags.HasError() {
+			return diags
+		}
+
+		asps, err := aspsService.List(userId).Do()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		for _, asp := range asps.Items {
+			err := aspsService.Delete(userId, asp.CodeId).Do()
+			if err != nil && !isNotFound(err) {
+				return diag.FromErr(fmt.Errorf("error deleting ASP %d for user %s: %s", asp.CodeId, userId, err))
+			}
+
+			revokedAspIds = append(revokedAspIds, asp.CodeId)
+		}
+	}
+
+	if d.Get("turn_off_two_step_verification").(bool) {
+		usersService, diags := GetUsersService(directoryService)
+		if diags.HasError() {
+			return diags
+		}
+
+		user, err := usersService.Get(userId).Do()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		// turning off 2-Step Verification fails for users that are not enrolled
+		if user.IsEnrolledIn2Sv {
+			twoStepVerificationService, diags := GetTwoStepVerificationService(directoryService)
+			if diags.HasError() {
+				return diags
+			}
+
+			err := twoStepVerificationService.TurnOff(userId).Do()
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	d.SetId(userId)
+	d.Set("revoked_client_ids", revokedClientIds)
+	d.Set("revoked_asp_ids", revokedAspIds)
+
+	log.Printf("[DEBUG] Finished revoking credentials of user %s", userId)
+
+	return resourceUserCredentialsRevocationRead(ctx, d, meta)
+}
+
+// The revocation is a one-off action, there is nothing to read back, all values are kept in state
+func resourceUserCredentialsRevocationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return nil
+}
+
+// Revoked credentials cannot be restored, the resource is only removed from state
+func resourceUserCredentialsRevocationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] Removing Credentials Revocation of user %q from state", d.Id())
+
+	return nil
+}
+
+// revokeUserTokens deletes all OAuth tokens of the user, and returns the Client IDs they were issued to
+func revokeUserTokens(tokensService *directory.TokensService, userId string) ([]string, error) {
+	revokedClientIds := []string{}
+
+	tokens, err := tokensService.List(userId).Do()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, token := range tokens.Items {
+		err := tokensService.Delete(userId, token.ClientId).Do()
+		if err != nil && !isNotFound(err) {
+			return nil, fmt.Errorf("error deleting OAuth token of %s for user %s: %s", token.ClientId, userId, err)
+		}
+
+		revokedClientIds = append(revokedClientIds, token.ClientId)
+	}
+
+	return revokedClientIds, nil
+}
@@ -0,0 +1,286 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	directory "google.golang.org/api/admin/directory/v1"
+	"google.golang.org/api/googleapi"
+)
+
+func resourceUserCustomAttributes() *schema.Resource {
+	return &schema.Resource{
+		Description: "User Custom Attributes resource manages the values of a single custom schema on a Google " +
+			"Workspace User. Values of other schemas are left untouched, so several configurations can each manage " +
+			"their own schemas on the same user. When used alongside `googleworkspace_user`, the user resource should " +
+			"not manage the `custom_schemas` attribute (e.g. with `lifecycle { ignore_changes = [custom_schemas] }`). " +
+			"User Custom Attributes resides under the `https://www.googleapis.com/auth/admin.directory.user` client scope.",
+
+		CreateContext: resourceUserCustomAttributesCreate,
+		ReadContext:   resourceUserCustomAttributesRead,
+		UpdateContext: resourceUserCustomAttributesUpdate,
+		DeleteContext: resourceUserCustomAttributesDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceUserCustomAttributesImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The ID of this resource, in the format `users/{user_id}/customSchemas/{schema_name}`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"user_id": {
+				Description: "The user's primary email address, alias email address, or unique user ID.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"schema_name": {
+				Description: "The name of the custom schema.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"schema_values": {
+				Description: "JSON encoded map that represents key/value pairs that " +
+					"correspond to the given schema.",
+				Type:     schema.TypeMap,
+				Required: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateDiagFunc: validation.ToDiagFunc(
+						validation.StringIsJSON,
+					),
+				},
+			},
+		},
+	}
+}
+
+func resourceUserCustomAttributesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	userId := d.Get("user_id").(string)
+	schemaName := d.Get("schema_name").(string)
+	log.Printf("[DEBUG] Creating User Custom Attributes %q for user %s", schemaName, userId)
+
+	diags := patchUserCustomAttributes(ctx, d, meta, d.Timeout(schema.TimeoutCreate))
+	if diags.HasError() {
+		return diags
+	}
+
+	d.SetId(fmt.Sprintf("users/%s/customSchemas/%s", userId, schemaName))
+
+	log.Printf("[DEBUG] Finished creating User Custom Attributes %q for user %s", schemaName, userId)
+
+	return resourceUserCustomAttributesRead(ctx, d, meta)
+}
+
+func resourceUserCustomAttributesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return diags
+	}
+
+	usersService, diags := GetUsersService(directoryService)
+	if diags.HasError() {
+		return diags
+	}
+
+	userId := d.Get("user_id").(string)
+	schemaName := d.Get("schema_name").(string)
+	log.Printf("[DEBUG] Getting User Custom Attributes %q for user %s", schemaName, userId)
+
+	user, err := usersService.Get(userId).Projection("custom").CustomFieldMask(schemaName).Do()
+	if err != nil {
+		return handleNotFoundError(err, d, d.Id())
+	}
+
+	schemaValues := map[string]interface{}{}
+	if sv, ok := user.CustomSchemas[schemaName]; ok {
+		customSchemas, diags := flattenCustomSchemas(map[string]googleapi.RawMessage{schemaName: sv}, client)
+		if diags.HasError() {
+			return diags
+		}
+
+		if len(customSchemas) > 0 {
+			schemaValues = customSchemas[0]["schema_values"].(map[string]interface{})
+		}
+	}
+
+	d.Set("schema_values", schemaValues)
+
+	log.Printf("[DEBUG] Finished getting User Custom Attributes %q for user %s", schemaName, userId)
+
+	return diags
+}
+
+func resourceUserCustomAttributesUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] Updating User Custom Attributes %q", d.Id())
+
+	if d.HasChange("schema_values") {
+		diags := patchUserCustomAttributes(ctx, d, meta, d.Timeout(schema.TimeoutUpdate))
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	log.Printf("[DEBUG] Finished updating User Custom Attributes %q", d.Id())
+
+	return resourceUserCustomAttributesRead(ctx, d, meta)
+}
+
+func resourceUserCustomAttributesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return diags
+	}
+
+	usersService, diags := GetUsersService(directoryService)
+	if diags.HasError() {
+		return diags
+	}
+
+	userId := d.Get("user_id").(string)
+	schemaName := d.Get("schema_name").(string)
+	log.Printf("[DEBUG] Deleting User Custom Attributes %q for user %s", schemaName, userId)
+
+	// clear every field of the schema, leaving other schemas untouched
+	schemaValues := map[string]interface{}{}
+	for k := range d.Get("schema_values").(map[string]interface{}) {
+		schemaValues[k] = "null"
+	}
+
+	customSchemas, diags := expandCustomSchemaValues([]interface{}{
+		map[string]interface{}{
+			"schema_name":   schemaName,
+			"schema_values": schemaValues,
+		},
+	})
+	if diags.HasError() {
+		return diags
+	}
+
+	_, err := usersService.Patch(userId, &directory.User{
+		CustomSchemas: customSchemas,
+	}).Do()
+	if err != nil {
+		return handleNotFoundError(err, d, d.Id())
+	}
+
+	log.Printf("[DEBUG] Finished deleting User Custom Attributes %q for user %s", schemaName, userId)
+
+	return diags
+}
+
+func resourceUserCustomAttributesImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 4 || parts[0] != "users" || parts[1] == "" || parts[2] != "customSchemas" || parts[3] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected users/{user_id}/customSchemas/{schema_name}", d.Id())
+	}
+
+	d.Set("user_id", parts[1])
+	d.Set("schema_name", parts[3])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// patchUserCustomAttributes patches the values of the schema on the user, fields
+// that were removed from the configuration are cleared
+func patchUserCustomAttributes(ctx context.Context, d *schema.ResourceData, meta interface{}, timeout time.Duration) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return diags
+	}
+
+	usersService, diags := GetUsersService(directoryService)
+	if diags.HasError() {
+		return diags
+	}
+
+	userId := d.Get("user_id").(string)
+	schemaName := d.Get("schema_name").(string)
+
+	old, new := d.GetChange("schema_values")
+
+	customSchema := map[string]interface{}{
+		"schema_name":   schemaName,
+		"schema_values": new,
+	}
+
+	diags = validateCustomSchemaValues([]interface{}{customSchema}, client)
+	if diags.HasError() {
+		return diags
+	}
+
+	schemaValues := map[string]interface{}{}
+	for k := range old.(map[string]interface{}) {
+		schemaValues[k] = "null"
+	}
+	for k, v := range new.(map[string]interface{}) {
+		schemaValues[k] = v
+	}
+	customSchema["schema_values"] = schemaValues
+
+	customSchemas, diags := expandCustomSchemaValues([]interface{}{customSchema})
+	if diags.HasError() {
+		return diags
+	}
+
+	_, err := usersService.Patch(userId, &directory.User{
+		CustomSchemas: customSchemas,
+	}).Do()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// PATCH will respond with the updated User, however, it is eventually consistent,
+	// once we get a consistent etag, we can feel confident that our values are also consistent
+	cc := consistencyCheck{
+		resourceType: "user custom attributes",
+		timeout:      timeout,
+	}
+
+	err = retryTimeDuration(ctx, timeout, func() error {
+		if cc.reachedConsistency(1) {
+			return nil
+		}
+
+		newUser, retryErr := usersService.Get(userId).IfNoneMatch(cc.lastEtag).Do()
+		if googleapi.IsNotModified(retryErr) {
+			cc.currConsistent += 1
+		} else if retryErr != nil {
+			return fmt.Errorf("unexpected error during retries of %s: %s", cc.resourceType, retryErr)
+		} else {
+			cc.handleNewEtag(newUser.Etag)
+		}
+
+		return fmt.Errorf("timed out while waiting for %s to be updated", cc.resourceType)
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
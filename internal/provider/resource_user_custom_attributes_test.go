@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceUserCustomAttributes_basic(t *testing.T) {
+	t.Parallel()
+
+	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+
+	if domainName == "" {
+		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
+	}
+
+	testUserVals := map[string]interface{}{
+		"domainName": domainName,
+		"userEmail":  fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+		"password":   acctest.RandString(10),
+		"fooValue":   "foo",
+		"barValue":   "bar",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceUserCustomAttributes(testUserVals),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_user_custom_attributes.foo", "schema_values.foo", "\"foo\""),
+					resource.TestCheckResourceAttr("googleworkspace_user_custom_attributes.bar", "schema_values.bar", "\"bar\""),
+				),
+			},
+			{
+				ResourceName:      "googleworkspace_user_custom_attributes.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// updating one schema must not affect the other
+				Config: testAccResourceUserCustomAttributes(map[string]interface{}{
+					"domainName": domainName,
+					"userEmail":  testUserVals["userEmail"],
+					"password":   testUserVals["password"],
+					"fooValue":   "foo-updated",
+					"barValue":   "bar",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_user_custom_attributes.foo", "schema_values.foo", "\"foo-updated\""),
+					resource.TestCheckResourceAttr("googleworkspace_user_custom_attributes.bar", "schema_values.bar", "\"bar\""),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceUserCustomAttributes(testUserVals map[string]interface{}) string {
+	return Nprintf(`
+resource "googleworkspace_schema" "foo" {
+  schema_name = "%{userEmail}-foo"
+
+  fields {
+    field_name = "foo"
+    field_type = "STRING"
+  }
+}
+
+resource "googleworkspace_schema" "bar" {
+  schema_name = "%{userEmail}-bar"
+
+  fields {
+    field_name = "bar"
+    field_type = "STRING"
+  }
+}
+
+resource "googleworkspace_user" "test" {
+  primary_email = "%{userEmail}@%{domainName}"
+  password      = "%{password}"
+
+  name {
+    family_name = "Scott"
+    given_name  = "Michael"
+  }
+
+  lifecycle {
+    ignore_changes = [custom_schemas]
+  }
+}
+
+resource "googleworkspace_user_custom_attributes" "foo" {
+  user_id     = googleworkspace_user.test.id
+  schema_name = googleworkspace_schema.foo.schema_name
+
+  schema_values = {
+    foo = jsonencode("%{fooValue}")
+  }
+}
+
+resource "googleworkspace_user_custom_attributes" "bar" {
+  user_id     = googleworkspace_user.test.id
+  schema_name = googleworkspace_schema.bar.schema_name
+
+  schema_values = {
+    bar = jsonencode("%{barValue}")
+  }
+}
+`, testUserVals)
+}
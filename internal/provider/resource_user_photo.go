@@ -0,0 +1,296 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mitchellh/go-homedir"
+
+	directory "google.golang.org/api/admin/directory/v1"
+)
+
+// maxUserPhotoSize is the largest photo accepted by the provider, the API downsizes
+// every photo to 96x96 pixels, so there is no benefit in uploading larger files
+const maxUserPhotoSize = 5 * 1024 * 1024
+
+func resourceUserPhoto() *schema.Resource {
+	return &schema.Resource{
+		Description: "User Photo resource manages the profile photo of a Google Workspace User. The photo is " +
+			"uploaded from a local JPEG, PNG, GIF, BMP or TIFF file and is re-uploaded whenever the file, or the " +
+			"photo in Google Workspace, changes. User Photo resides under the " +
+			"`https://www.googleapis.com/auth/admin.directory.user` client scope.",
+
+		CreateContext: resourceUserPhotoCreate,
+		ReadContext:   resourceUserPhotoRead,
+		UpdateContext: resourceUserPhotoUpdate,
+		DeleteContext: resourceUserPhotoDelete,
+
+		CustomizeDiff: resourceUserPhotoCustomizeDiff,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"user_id": {
+				Description: "The user's primary email address, alias email address, or unique user ID.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"source": {
+				Description: "The path to the local image file to upload. The file must be at most 5MB.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"content_sha256": {
+				Description: "The SHA-256 hash of the uploaded file contents, used to detect changes to the local file.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"mime_type": {
+				Description: "The MIME type of the photo, detected from the file contents.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"height": {
+				Description: "Height of the photo in pixels.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"width": {
+				Description: "Width of the photo in pixels.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"etag": {
+				Description: "ETag of the photo, used to detect changes made outside of Terraform.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceUserPhotoCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	userId := d.Get("user_id").(string)
+	log.Printf("[DEBUG] Creating User Photo for user %q", userId)
+
+	diags := uploadUserPhoto(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Finished creating User Photo %q", d.Id())
+
+	return resourceUserPhotoRead(ctx, d, meta)
+}
+
+func resourceUserPhotoRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return diags
+	}
+
+	usersService, diags := GetUsersService(directoryService)
+	if diags.HasError() {
+		return diags
+	}
+
+	photosService, diags := GetUserPhotosService(usersService)
+	if diags.HasError() {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Getting User Photo %q", d.Id())
+
+	photo, err := photosService.Get(d.Id()).Do()
+	if err != nil {
+		return handleNotFoundError(err, d, d.Id())
+	}
+
+	// the photo was changed outside of Terraform, clear the hash so it is uploaded again
+	if etag := d.Get("etag").(string); etag != "" && etag != photo.Etag {
+		log.Printf("[DEBUG] User Photo %q etag changed from %q to %q", d.Id(), etag, photo.Etag)
+		d.Set("content_sha256", "")
+	}
+
+	// user_id is only unset on import
+	if d.Get("user_id").(string) == "" {
+		d.Set("user_id", d.Id())
+	}
+
+	d.SetId(photo.Id)
+	d.Set("mime_type", photo.MimeType)
+	d.Set("height", photo.Height)
+	d.Set("width", photo.Width)
+	d.Set("etag", photo.Etag)
+
+	log.Printf("[DEBUG] Finished getting User Photo %q", d.Id())
+
+	return diags
+}
+
+func resourceUserPhotoUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] Updating User Photo %q", d.Id())
+
+	if d.HasChanges("source", "content_sha256") {
+		diags := uploadUserPhoto(d, meta)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	log.Printf("[DEBUG] Finished updating User Photo %q", d.Id())
+
+	return resourceUserPhotoRead(ctx, d, meta)
+}
+
+func resourceUserPhotoDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return diags
+	}
+
+	usersService, diags := GetUsersService(directoryService)
+	if diags.HasError() {
+		return diags
+	}
+
+	photosService, diags := GetUserPhotosService(usersService)
+	if diags.HasError() {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Deleting User Photo %q", d.Id())
+
+	err := photosService.Delete(d.Id()).Do()
+	if err != nil {
+		return handleNotFoundError(err, d, d.Id())
+	}
+
+	log.Printf("[DEBUG] Finished deleting User Photo %q", d.Id())
+
+	return diags
+}
+
+func resourceUserPhotoCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	photo, err := readUserPhotoSource(diff.Get("source").(string))
+	if err != nil {
+		return err
+	}
+
+	hash := sha256.Sum256(photo)
+	if hex.EncodeToString(hash[:]) != diff.Get("content_sha256").(string) {
+		return diff.SetNew("content_sha256", hex.EncodeToString(hash[:]))
+	}
+
+	return nil
+}
+
+func uploadUserPhoto(d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return diags
+	}
+
+	usersService, diags := GetUsersService(directoryService)
+	if diags.HasError() {
+		return diags
+	}
+
+	photosService, diags := GetUserPhotosService(usersService)
+	if diags.HasError() {
+		return diags
+	}
+
+	photo, err := readUserPhotoSource(d.Get("source").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	photoObj := directory.UserPhoto{
+		MimeType:  userPhotoMimeType(photo),
+		PhotoData: base64.URLEncoding.EncodeToString(photo),
+	}
+
+	userPhoto, err := photosService.Update(d.Get("user_id").(string), &photoObj).Do()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// the photo is identified by the unique ID of the user it belongs to
+	d.SetId(userPhoto.Id)
+
+	hash := sha256.Sum256(photo)
+	d.Set("content_sha256", hex.EncodeToString(hash[:]))
+
+	return diags
+}
+
+// readUserPhotoSource reads the photo from the local file, validating its size and type
+func readUserPhotoSource(source string) ([]byte, error) {
+	path, err := homedir.Expand(source)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if info.Size() > maxUserPhotoSize {
+		return nil, fmt.Errorf("photo %s is %d bytes, the maximum size is %d bytes", source, info.Size(), maxUserPhotoSize)
+	}
+
+	photo, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if userPhotoMimeType(photo) == "" {
+		return nil, fmt.Errorf("photo %s must be a JPEG, PNG, GIF, BMP or TIFF image, got %s", source, http.DetectContentType(photo))
+	}
+
+	return photo, nil
+}
+
+// userPhotoMimeType returns the API MIME type for the photo, or an empty string if it is unsupported
+func userPhotoMimeType(photo []byte) string {
+	switch http.DetectContentType(photo) {
+	case "image/jpeg":
+		return "JPEG"
+	case "image/png":
+		return "PNG"
+	case "image/gif":
+		return "GIF"
+	case "image/bmp":
+		return "BMP"
+	}
+
+	// TIFF is not detected by http.DetectContentType, check for the little and big endian headers
+	if bytes.HasPrefix(photo, []byte("II*\x00")) || bytes.HasPrefix(photo, []byte("MM\x00*")) {
+		return "TIFF"
+	}
+
+	return ""
+}
@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceUserPhoto_basic(t *testing.T) {
+	t.Parallel()
+
+	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+
+	if domainName == "" {
+		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
+	}
+
+	testUserVals := map[string]interface{}{
+		"domainName": domainName,
+		"userEmail":  fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+		"password":   acctest.RandString(10),
+		"source":     filepath.Join(t.TempDir(), "photo.png"),
+	}
+
+	testAccWriteUserPhotoImage(t, testUserVals["source"].(string), color.White)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceUserPhoto(testUserVals),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_user_photo.test", "mime_type", "PNG"),
+					resource.TestCheckResourceAttrSet("googleworkspace_user_photo.test", "etag"),
+					resource.TestCheckResourceAttrPair("googleworkspace_user_photo.test", "id", "googleworkspace_user.test", "id"),
+				),
+			},
+			{
+				ResourceName:            "googleworkspace_user_photo.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"source", "content_sha256"},
+			},
+			{
+				// changing the file contents should upload the photo again
+				PreConfig: func() { testAccWriteUserPhotoImage(t, testUserVals["source"].(string), color.Black) },
+				Config:    testAccResourceUserPhoto(testUserVals),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_user_photo.test", "mime_type", "PNG"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceUserPhoto_readUserPhotoSource(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	source := filepath.Join(dir, "photo.png")
+	testAccWriteUserPhotoImage(t, source, color.White)
+
+	photo, err := readUserPhotoSource(source)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if mimeType := userPhotoMimeType(photo); mimeType != "PNG" {
+		t.Errorf("expected mime type PNG, got %q", mimeType)
+	}
+
+	tiff := filepath.Join(dir, "photo.tiff")
+	if err := os.WriteFile(tiff, []byte("II*\x00rest-of-the-file"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	photo, err = readUserPhotoSource(tiff)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if mimeType := userPhotoMimeType(photo); mimeType != "TIFF" {
+		t.Errorf("expected mime type TIFF, got %q", mimeType)
+	}
+
+	text := filepath.Join(dir, "photo.txt")
+	if err := os.WriteFile(text, []byte("not an image"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := readUserPhotoSource(text); err == nil {
+		t.Errorf("expected error for unsupported file type")
+	}
+
+	large := filepath.Join(dir, "large.png")
+	if err := os.WriteFile(large, make([]byte, maxUserPhotoSize+1), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := readUserPhotoSource(large); err == nil {
+		t.Errorf("expected error for file larger than %d bytes", maxUserPhotoSize)
+	}
+}
+
+func testAccWriteUserPhotoImage(t *testing.T, path string, c color.Color) {
+	img := image.NewRGBA(image.Rect(0, 0, 96, 96))
+	for x := 0; x < 96; x++ {
+		for y := 0; y < 96; y++ {
+			img.Set(x, y, c)
+		}
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	if err := png.Encode(f, img); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testAccResourceUserPhoto(testUserVals map[string]interface{}) string {
+	return Nprintf(`
+resource "googleworkspace_user" "test" {
+  primary_email = "%{userEmail}@%{domainName}"
+  password      = "%{password}"
+
+  name {
+    family_name = "Scott"
+    given_name  = "Michael"
+  }
+}
+
+resource "googleworkspace_user_photo" "test" {
+  user_id = googleworkspace_user.test.id
+  source  = "%{source}"
+}
+`, testUserVals)
+}
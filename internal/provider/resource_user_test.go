@@ -4,14 +4,21 @@
 package googleworkspace
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"os"
+	"path/filepath"
+	"reflect"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	directory "google.golang.org/api/admin/directory/v1"
 )
 
 func TestAccResourceUser_basic(t *testing.T) {
@@ -87,6 +94,41 @@ func checkUserImportState() resource.ImportStateCheckFunc {
 	)
 }
 
+func TestAccResourceUser_importByAlias(t *testing.T) {
+	t.Parallel()
+
+	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+
+	if domainName == "" {
+		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
+	}
+
+	testUserVals := map[string]interface{}{
+		"domainName": domainName,
+		"userEmail":  fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+		"password":   acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceUser_alias(testUserVals),
+			},
+			{
+				// TestStep imports by one of the aliases of the user
+				ResourceName:            "googleworkspace_user.my-new-user",
+				ImportState:             true,
+				ImportStateId:           fmt.Sprintf("%s-alias@%s", testUserVals["userEmail"], domainName),
+				ImportStateCheck:        checkUserImportState(),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"etag", "password"},
+			},
+		},
+	})
+}
+
 func TestAccResourceUser_noPassword(t *testing.T) {
 	t.Parallel()
 
@@ -106,8 +148,798 @@ func TestAccResourceUser_noPassword(t *testing.T) {
 		ProviderFactories: providerFactories,
 		Steps: []resource.TestStep{
 			{
-				Config:      testAccResourceUser_noPassword(testUserVals),
-				ExpectError: regexp.MustCompile("Password is required"),
+				Config:      testAccResourceUser_noPassword(testUserVals),
+				ExpectError: regexp.MustCompile("Password is required"),
+			},
+		},
+	})
+}
+
+func TestAccResourceUser_customSchemasPlanValidation(t *testing.T) {
+	t.Parallel()
+
+	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+
+	if domainName == "" {
+		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
+	}
+
+	testUserVals := map[string]interface{}{
+		"domainName": domainName,
+		"userEmail":  fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+		"password":   acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceUser_customSchemasPlanValidationSchema(testUserVals),
+			},
+			{
+				Config:      testAccResourceUser_customSchemasPlanValidation(testUserVals),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`custom_schemas\.0\.schema_values\.favorite-numbers: value 200`),
+			},
+			{
+				Config:      testAccResourceUser_customSchemasMissingSchema(testUserVals),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`custom_schemas\.0\.schema_name: schema ".*-missing" not found`),
+			},
+		},
+	})
+}
+
+func TestAccResourceUser_passwordWriteOnly(t *testing.T) {
+	t.Parallel()
+
+	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+
+	if domainName == "" {
+		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
+	}
+
+	testUserVals := map[string]interface{}{
+		"domainName":      domainName,
+		"userEmail":       fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+		"password":        acctest.RandString(10),
+		"passwordVersion": 1,
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceUser_passwordWriteOnly(testUserVals),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr("googleworkspace_user.my-new-user", "password_wo"),
+					resource.TestCheckResourceAttr("googleworkspace_user.my-new-user", "password_version", "1"),
+				),
+			},
+			{
+				Config: testAccResourceUser_passwordWriteOnly(map[string]interface{}{
+					"domainName":      domainName,
+					"userEmail":       testUserVals["userEmail"],
+					"password":        acctest.RandString(10),
+					"passwordVersion": 2,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr("googleworkspace_user.my-new-user", "password_wo"),
+					resource.TestCheckResourceAttr("googleworkspace_user.my-new-user", "password_version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceUser_generatedPassword(t *testing.T) {
+	t.Parallel()
+
+	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+
+	if domainName == "" {
+		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
+	}
+
+	testUserVals := map[string]interface{}{
+		"domainName": domainName,
+		"userEmail":  fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+		"outputFile": filepath.Join(t.TempDir(), "password"),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceUser_generatedPassword(testUserVals),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_user.my-new-user", "password", ""),
+					resource.TestCheckResourceAttr("googleworkspace_user.my-new-user", "change_password_at_next_login", "true"),
+					func(s *terraform.State) error {
+						password, err := os.ReadFile(testUserVals["outputFile"].(string))
+						if err != nil {
+							return err
+						}
+
+						if len(password) != 16 {
+							return fmt.Errorf("expected generated password of length 16, got %d", len(password))
+						}
+
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestResourceUser_generatePassword(t *testing.T) {
+	t.Parallel()
+
+	for _, length := range []int{12, 24, 100} {
+		password, err := generatePassword(length)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if len(password) != length {
+			t.Errorf("expected password of length %d, got %d", length, len(password))
+		}
+
+		for _, chars := range []string{passwordLowerChars, passwordUpperChars, passwordNumberChars, passwordSpecialChars} {
+			if !strings.ContainsAny(password, chars) {
+				t.Errorf("expected password %q to contain one of %q", password, chars)
+			}
+		}
+	}
+}
+
+func TestResourceUser_hashPassword(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]string{
+		"SHA-1": "5baa61e4c9b93f3f0682250b6cf8331b7ee68fd8",
+		"MD5":   "5f4dcc3b5aa765d61d8327deb882cf99",
+	}
+
+	for hashFunction, expected := range cases {
+		hashed, err := hashPassword("password", hashFunction)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if hashed != expected {
+			t.Errorf("expected %s hash %q, got %q", hashFunction, expected, hashed)
+		}
+	}
+
+	hashed, err := hashPassword("password", "crypt")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !strings.HasPrefix(hashed, "$6$") {
+		t.Errorf("expected crypt hash to use SHA-512, got %q", hashed)
+	}
+
+	if _, err := hashPassword("password", "bcrypt"); err == nil {
+		t.Errorf("expected error for unsupported hash function")
+	}
+}
+
+func TestResourceUser_nestedSetHash(t *testing.T) {
+	hash := userNestedSetHash("type", "custom_type", "value")
+
+	configured := map[string]interface{}{"type": "work", "custom_type": "", "value": "555-555-5555", "primary": false}
+	returned := map[string]interface{}{"type": "work", "value": "555-555-5555", "primary": true}
+
+	if hash(configured) != hash(returned) {
+		t.Errorf("expected unset keys and keys that are not hashed to be ignored")
+	}
+
+	posixHash := userNestedSetHash("username", "uid")
+	if posixHash(map[string]interface{}{"username": "mscott", "uid": 4294967296}) !=
+		posixHash(map[string]interface{}{"username": "mscott", "uid": float64(4294967296)}) {
+		t.Errorf("expected numbers decoded from the API to hash the same as configured numbers")
+	}
+
+	other := map[string]interface{}{"type": "home", "value": "555-555-5555"}
+	if hash(configured) == hash(other) {
+		t.Errorf("expected entries with a different type to hash differently")
+	}
+}
+
+func TestResourceUser_adoptionConflicts(t *testing.T) {
+	existing := &directory.User{
+		Name:          &directory.UserName{GivenName: "Michael", FamilyName: "Scott"},
+		OrgUnitPath:   "/Sales",
+		RecoveryEmail: "mscott@example.com",
+	}
+
+	userObj := &directory.User{
+		Name:        &directory.UserName{GivenName: "Michael", FamilyName: "Scott"},
+		OrgUnitPath: "/sales",
+		Suspended:   true,
+	}
+
+	conflicts := userAdoptionConflicts(existing, userObj)
+	if !reflect.DeepEqual(conflicts, []string{"suspended"}) {
+		t.Errorf("expected only suspended to conflict, got %v", conflicts)
+	}
+
+	userObj.Name.GivenName = "Mike"
+	userObj.RecoveryEmail = "mike@example.com"
+
+	conflicts = userAdoptionConflicts(existing, userObj)
+	if !reflect.DeepEqual(conflicts, []string{"name", "suspended", "recovery_email"}) {
+		t.Errorf("expected name, suspended and recovery_email to conflict, got %v", conflicts)
+	}
+}
+
+func TestResourceUser_expandPasswordUpdate(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceUser().Schema, map[string]interface{}{
+		"password":      "5f4dcc3b5aa765d61d8327deb882cf99",
+		"hash_function": "MD5",
+	})
+
+	// the password of new users is sent when creating them, adopted users keep theirs
+	d.MarkNewResource()
+	user := &directory.User{}
+	forceSendFields, diags := expandUserPasswordUpdate(d, user)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if user.Password != "" || user.HashFunction != "" || len(forceSendFields) > 0 {
+		t.Errorf("expected no password for a new user, got %q, %q and %v", user.Password, user.HashFunction, forceSendFields)
+	}
+
+	d = schema.TestResourceDataRaw(t, resourceUser().Schema, map[string]interface{}{
+		"password":      "5f4dcc3b5aa765d61d8327deb882cf99",
+		"hash_function": "MD5",
+	})
+	d.SetId("123")
+
+	user = &directory.User{}
+	if _, diags := expandUserPasswordUpdate(d, user); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if user.Password != "5f4dcc3b5aa765d61d8327deb882cf99" || user.HashFunction != "MD5" {
+		t.Errorf("expected the changed password of an existing user, got %q and %q", user.Password, user.HashFunction)
+	}
+}
+
+func TestResourceUser_expandNestedLists(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceUser().Schema, map[string]interface{}{
+		"phones": []interface{}{
+			map[string]interface{}{"type": "work", "value": "555-555-5555"},
+		},
+		"posix_accounts": []interface{}{
+			map[string]interface{}{"username": "mscott", "uid": "1000", "gid": ""},
+		},
+	})
+
+	user := &directory.User{
+		Phones: expandUserPhones(d.Get("phones")),
+		Emails: expandUserEmails(d.Get("emails")),
+	}
+
+	posixAccounts, err := expandUserPosixAccounts(d.Get("posix_accounts"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	user.PosixAccounts = posixAccounts
+
+	b, err := json.Marshal(user)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := `{"phones":[{"type":"work","value":"555-555-5555"}],"posixAccounts":[{"uid":"1000","username":"mscott"}]}`
+	if string(b) != expected {
+		t.Errorf("expected %s, got %s", expected, string(b))
+	}
+
+	d = schema.TestResourceDataRaw(t, resourceUser().Schema, map[string]interface{}{
+		"posix_accounts": []interface{}{
+			map[string]interface{}{"username": "mscott", "uid": "not-a-number"},
+		},
+	})
+
+	if _, err := expandUserPosixAccounts(d.Get("posix_accounts")); err == nil {
+		t.Errorf("expected an error for an invalid uid")
+	}
+}
+
+func TestResourceUser_flattenNestedLists(t *testing.T) {
+	user := &directory.User{
+		PrimaryEmail: "michael@example.com",
+		Emails: []interface{}{
+			map[string]interface{}{"address": "michael@example.com", "primary": true},
+			map[string]interface{}{"address": "michael.scott@example.net", "type": "home"},
+		},
+		Organizations: []interface{}{
+			map[string]interface{}{"name": "Dunder Mifflin", "fullTimeEquivalent": float64(100000), "primary": true},
+		},
+		PosixAccounts: []interface{}{
+			map[string]interface{}{"username": "mscott", "uid": "1000"},
+		},
+	}
+
+	nested, err := decodeUserNestedLists(user)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	emails := flattenUserEmails(nested.Emails, false)
+	if len(emails) != 1 || emails[0].(map[string]interface{})["address"] != "michael.scott@example.net" {
+		t.Errorf("expected only the typed email, got %#v", emails)
+	}
+
+	if emails := flattenUserEmails(nested.Emails, true); len(emails) != len(nested.Emails) {
+		t.Errorf("expected all the emails when including untyped emails, got %#v", emails)
+	}
+
+	organization := flattenUserOrganizations(nested.Organizations)[0].(map[string]interface{})
+	if organization["full_time_equivalent"] != 100000 || organization["primary"] != true || organization["title"] != "" {
+		t.Errorf("unexpected organization: %#v", organization)
+	}
+
+	account := flattenUserPosixAccounts(nested.PosixAccounts)[0].(map[string]interface{})
+	if account["uid"] != "1000" || account["gid"] != "" {
+		t.Errorf("unexpected posix account: %#v", account)
+	}
+}
+
+func TestResourceUser_validateCustomSchemaDefinition(t *testing.T) {
+	schemaDef := &directory.Schema{
+		SchemaName: "employment",
+		Fields: []*directory.SchemaFieldSpec{
+			{FieldName: "start_date", FieldType: "DATE"},
+			{FieldName: "manager", FieldType: "EMAIL"},
+			{FieldName: "level", FieldType: "INT64", NumericIndexingSpec: &directory.SchemaFieldSpecNumericIndexingSpec{MinValue: 1, MaxValue: 10}},
+			{FieldName: "remote", FieldType: "BOOL"},
+			{FieldName: "phones", FieldType: "PHONE", MultiValued: true},
+			{FieldName: "ratio", FieldType: "DOUBLE"},
+			{FieldName: "score", FieldType: "INT64", NumericIndexingSpec: &directory.SchemaFieldSpecNumericIndexingSpec{MaxValue: 100}},
+		},
+	}
+
+	cases := []struct {
+		name   string
+		values map[string]interface{}
+		err    string
+	}{
+		{"valid", map[string]interface{}{
+			"start_date": `"2020-01-31"`,
+			"manager":    `"michael@example.com"`,
+			"level":      `5`,
+			"remote":     `true`,
+			"phones":     `["555-555-5555", "555-555-5556"]`,
+			"ratio":      `0.5`,
+		}, ""},
+		{"unknown field", map[string]interface{}{"salary": `1`}, "custom_schemas.1.schema_values.salary: field name (salary) is not found"},
+		{"invalid date", map[string]interface{}{"start_date": `"31/01/2020"`}, "custom_schemas.1.schema_values.start_date: value provided for start_date is of incorrect type (expected type: DATE)"},
+		{"invalid int", map[string]interface{}{"level": `5.5`}, "expected type: INT64"},
+		{"above maximum", map[string]interface{}{"level": `11`}, "above the numeric indexing maximum 10"},
+		{"below minimum", map[string]interface{}{"level": `0`}, "below the numeric indexing minimum 1"},
+		{"only maximum set", map[string]interface{}{"score": `-5`}, ""},
+		{"above only maximum", map[string]interface{}{"score": `101`}, "above the numeric indexing maximum 100"},
+		{"date not a string", map[string]interface{}{"start_date": `20200131`}, "expected type: DATE"},
+		{"email not a string", map[string]interface{}{"manager": `true`}, "expected type: EMAIL"},
+		{"multi-valued not a list", map[string]interface{}{"phones": `"555-555-5555"`}, "is multi-valued and should be a list"},
+		{"multi-valued invalid item", map[string]interface{}{"phones": `["555-555-5555", 5]`}, "expected type: PHONE"},
+		{"single-valued list", map[string]interface{}{"remote": `[true]`}, "is not multi-valued and should not be a list"},
+		{"invalid json", map[string]interface{}{"remote": `yes`}, "custom_schemas.1.schema_values.remote: invalid character"},
+	}
+
+	for _, tc := range cases {
+		err := validateCustomSchemaDefinition(1, map[string]interface{}{
+			"schema_name":   "employment",
+			"schema_values": tc.values,
+		}, schemaDef)
+
+		if tc.err == "" {
+			if err != nil {
+				t.Errorf("%s: unexpected error: %s", tc.name, err)
+			}
+			continue
+		}
+
+		if err == nil || !strings.Contains(err.Error(), tc.err) {
+			t.Errorf("%s: expected error containing %q, got %v", tc.name, tc.err, err)
+		}
+	}
+}
+
+func TestResourceUser_stateUpgradeV0(t *testing.T) {
+	rawState := map[string]interface{}{
+		"id": "123",
+		"emails": []interface{}{
+			map[string]interface{}{"address": "michael@example.com", "primary": true, "type": ""},
+			map[string]interface{}{"address": "mscott@example.com"},
+			map[string]interface{}{"address": "michael.scott@example.net", "type": "home"},
+		},
+		"phones": []interface{}{
+			map[string]interface{}{"type": "work", "value": "555-555-5555"},
+		},
+	}
+
+	upgraded, err := resourceUserStateUpgradeV0(context.Background(), rawState, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	emails := upgraded["emails"].([]interface{})
+	if len(emails) != 1 || emails[0].(map[string]interface{})["address"] != "michael.scott@example.net" {
+		t.Errorf("expected only the typed email to be kept, got %#v", emails)
+	}
+
+	if len(upgraded["phones"].([]interface{})) != 1 {
+		t.Errorf("expected phones to be unchanged, got %#v", upgraded["phones"])
+	}
+}
+
+func TestAccResourceUser_deletionPolicySuspend(t *testing.T) {
+	t.Parallel()
+
+	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+
+	if domainName == "" {
+		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
+	}
+
+	testUserVals := map[string]interface{}{
+		"domainName":     domainName,
+		"userEmail":      fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+		"password":       acctest.RandString(10),
+		"deletionPolicy": "SUSPEND",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		// the suspended user is left behind by the provider, and has to be deleted by the test
+		CheckDestroy: testAccDeleteUser(fmt.Sprintf("%s@%s", testUserVals["userEmail"], domainName)),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceUser_deletionPolicy(testUserVals),
+			},
+			{
+				// the user is suspended instead of deleted, and can be imported again
+				Config:  testAccResourceUser_deletionPolicy(testUserVals),
+				Destroy: true,
+			},
+			{
+				ResourceName:  "googleworkspace_user.my-new-user",
+				Config:        testAccResourceUser_deletionPolicy(testUserVals),
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf("%s@%s", testUserVals["userEmail"], domainName),
+				ImportStateCheck: func(state []*terraform.InstanceState) error {
+					if state[0].Attributes["suspended"] != "true" {
+						return fmt.Errorf("expected user to be suspended, got suspended = %s", state[0].Attributes["suspended"])
+					}
+
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func testAccDeleteUser(email string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client, err := googleworkspaceTestClient()
+		if err != nil {
+			return err
+		}
+
+		directoryService, diags := client.NewDirectoryService()
+		if diags.HasError() {
+			return fmt.Errorf("Error creating directory service %+v", diags)
+		}
+
+		usersService, diags := GetUsersService(directoryService)
+		if diags.HasError() {
+			return fmt.Errorf("Error getting users service %+v", diags)
+		}
+
+		err = usersService.Delete(email).Do()
+		if err != nil && !isNotFound(err) {
+			return fmt.Errorf("Error deleting user %s: %s", email, err)
+		}
+
+		return nil
+	}
+}
+
+func TestAccResourceUser_undeleteOnCreate(t *testing.T) {
+	t.Parallel()
+
+	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+
+	if domainName == "" {
+		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
+	}
+
+	testUserVals := map[string]interface{}{
+		"domainName":     domainName,
+		"userEmail":      fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+		"password":       acctest.RandString(10),
+		"deletionPolicy": "DELETE",
+	}
+
+	var userId string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceUser_deletionPolicy(testUserVals),
+				Check: func(s *terraform.State) error {
+					userId = s.RootModule().Resources["googleworkspace_user.my-new-user"].Primary.ID
+					return nil
+				},
+			},
+			{
+				Config:  testAccResourceUser_deletionPolicy(testUserVals),
+				Destroy: true,
+			},
+			{
+				Config: testAccResourceUser_deletionPolicy(testUserVals),
+				Check: func(s *terraform.State) error {
+					id := s.RootModule().Resources["googleworkspace_user.my-new-user"].Primary.ID
+					if id != userId {
+						return fmt.Errorf("expected deleted user %s to be restored, got new user %s", userId, id)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceUser_adoptExisting(t *testing.T) {
+	t.Parallel()
+
+	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+
+	if domainName == "" {
+		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
+	}
+
+	testUserVals := map[string]interface{}{
+		"domainName": domainName,
+		"userEmail":  fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+		"password":   acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceUser_basic(testUserVals),
+			},
+			{
+				Config: testAccResourceUser_adoptExisting(testUserVals),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("googleworkspace_user.adopted", "id", "googleworkspace_user.my-new-user", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceUser_adoptExistingWithPassword(t *testing.T) {
+	t.Parallel()
+
+	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+
+	if domainName == "" {
+		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
+	}
+
+	testUserVals := map[string]interface{}{
+		"domainName": domainName,
+		"userEmail":  fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+		"password":   acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceUser_basic(testUserVals),
+			},
+			{
+				// the configured password is not sent, the API can't read passwords back, so
+				// TestResourceUser_expandPasswordUpdate checks that it isn't sent by the update after create
+				Config: testAccResourceUser_adoptExistingWithPassword(testUserVals),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("googleworkspace_user.adopted", "id", "googleworkspace_user.my-new-user", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceUser_signOutOnSuspend(t *testing.T) {
+	t.Parallel()
+
+	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+
+	if domainName == "" {
+		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
+	}
+
+	testUserVals := map[string]interface{}{
+		"domainName": domainName,
+		"userEmail":  fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+		"password":   acctest.RandString(10),
+		"suspended":  false,
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceUser_signOutOnSuspend(testUserVals),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_user.my-new-user", "last_sign_out_time", ""),
+				),
+			},
+			{
+				Config: testAccResourceUser_signOutOnSuspend(map[string]interface{}{
+					"domainName": domainName,
+					"userEmail":  testUserVals["userEmail"],
+					"password":   testUserVals["password"],
+					"suspended":  true,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_user.my-new-user", "suspended", "true"),
+					resource.TestCheckResourceAttrSet("googleworkspace_user.my-new-user", "last_sign_out_time"),
+					resource.TestCheckResourceAttr("googleworkspace_user.my-new-user", "last_revoked_client_ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceUser_updateModePatch(t *testing.T) {
+	t.Parallel()
+
+	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+
+	if domainName == "" {
+		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
+	}
+
+	testUserVals := map[string]interface{}{
+		"domainName": domainName,
+		"userEmail":  fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+		"password":   acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceUser_updateModePatch(testUserVals),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_user.my-new-user", "update_mode", "PATCH"),
+					resource.TestCheckResourceAttr("googleworkspace_user.my-new-user", "phones.#", "1"),
+					resource.TestCheckResourceAttr("googleworkspace_user.my-new-user", "recovery_email", "mscott@example.com"),
+				),
+			},
+			{
+				// the phones and recovery email are no longer managed, but are kept on the user
+				Config: testAccResourceUser_updateModePatchUnmanaged(testUserVals),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_user.my-new-user", "phones.#", "0"),
+					resource.TestCheckResourceAttr("googleworkspace_user.my-new-user", "recovery_email", ""),
+					resource.TestCheckResourceAttr("googleworkspace_user.my-new-user", "name.0.given_name", "Jim"),
+				),
+			},
+			{
+				Config: testAccResourceUser_updateModePatchUnmanagedDataSource(testUserVals),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.googleworkspace_user.my-new-user", "phones.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.googleworkspace_user.my-new-user", "phones.*", map[string]string{
+						"value": "555-555-5555",
+					}),
+					resource.TestCheckResourceAttr("data.googleworkspace_user.my-new-user", "recovery_email", "mscott@example.com"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceUser_renameKeepOldEmail(t *testing.T) {
+	t.Parallel()
+
+	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+
+	if domainName == "" {
+		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
+	}
+
+	testUserVals := map[string]interface{}{
+		"domainName": domainName,
+		"userEmail":  fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+		"password":   acctest.RandString(10),
+	}
+
+	oldEmail := fmt.Sprintf("%s@%s", testUserVals["userEmail"], domainName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceUser_rename(testUserVals, ""),
+			},
+			{
+				// the old primary email is kept as an alias, without a diff on aliases
+				Config: testAccResourceUser_rename(testUserVals, "-renamed"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_user.my-new-user", "primary_email", fmt.Sprintf("%s-renamed@%s", testUserVals["userEmail"], domainName)),
+					resource.TestCheckResourceAttr("googleworkspace_user.my-new-user", "previous_email_aliases.#", "1"),
+					resource.TestCheckResourceAttr("googleworkspace_user.my-new-user", "previous_email_aliases.0", oldEmail),
+					resource.TestCheckResourceAttr("googleworkspace_user.my-new-user", "aliases.#", "1"),
+				),
+			},
+			{
+				// the emails kept by earlier renames stay in previous_email_aliases
+				Config: testAccResourceUser_rename(testUserVals, "-renamed-again"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_user.my-new-user", "previous_email_aliases.#", "2"),
+					resource.TestCheckResourceAttr("googleworkspace_user.my-new-user", "previous_email_aliases.0", oldEmail),
+					resource.TestCheckResourceAttr("googleworkspace_user.my-new-user", "previous_email_aliases.1", fmt.Sprintf("%s-renamed@%s", testUserVals["userEmail"], domainName)),
+					resource.TestCheckResourceAttr("googleworkspace_user.my-new-user", "aliases.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceUser_renameDeleteOldEmail(t *testing.T) {
+	t.Parallel()
+
+	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+
+	if domainName == "" {
+		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
+	}
+
+	testUserVals := map[string]interface{}{
+		"domainName":   domainName,
+		"userEmail":    fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+		"password":     acctest.RandString(10),
+		"keepOldEmail": "false",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceUser_rename(testUserVals, ""),
+			},
+			{
+				Config: testAccResourceUser_rename(testUserVals, "-renamed"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_user.my-new-user", "previous_email_aliases.#", "0"),
+					resource.TestCheckResourceAttr("googleworkspace_user.my-new-user", "aliases.#", "1"),
+				),
 			},
 		},
 	})
@@ -310,6 +1142,22 @@ func TestAccResourceUser_customSchemasMultiple(t *testing.T) {
 	})
 }
 
+func testAccResourceUser_alias(testUserVals map[string]interface{}) string {
+	return Nprintf(`
+resource "googleworkspace_user" "my-new-user" {
+  primary_email = "%{userEmail}@%{domainName}"
+  password      = "%{password}"
+
+  name {
+    family_name = "Scott"
+    given_name  = "Michael"
+  }
+
+  aliases = ["%{userEmail}-alias@%{domainName}"]
+}
+`, testUserVals)
+}
+
 func testAccResourceUser_basic(testUserVals map[string]interface{}) string {
 	return Nprintf(`
 resource "googleworkspace_user" "my-new-user" {
@@ -341,6 +1189,210 @@ resource "googleworkspace_user" "my-new-user" {
 `, testUserVals)
 }
 
+func testAccResourceUser_passwordWriteOnly(testUserVals map[string]interface{}) string {
+	return Nprintf(`
+resource "googleworkspace_user" "my-new-user" {
+  primary_email    = "%{userEmail}@%{domainName}"
+  password_wo      = "%{password}"
+  password_version = %{passwordVersion}
+
+  name {
+    family_name = "Scott"
+    given_name = "Michael"
+  }
+}
+`, testUserVals)
+}
+
+func testAccResourceUser_generatedPassword(testUserVals map[string]interface{}) string {
+	return Nprintf(`
+resource "googleworkspace_user" "my-new-user" {
+  primary_email = "%{userEmail}@%{domainName}"
+  hash_function = "SHA-1"
+
+  # change_password_at_next_login is forced to true
+  generated_password {
+    length      = 16
+    output_file = "%{outputFile}"
+  }
+
+  name {
+    family_name = "Scott"
+    given_name = "Michael"
+  }
+}
+`, testUserVals)
+}
+
+func testAccResourceUser_deletionPolicy(testUserVals map[string]interface{}) string {
+	return Nprintf(`
+resource "googleworkspace_user" "my-new-user" {
+  primary_email      = "%{userEmail}@%{domainName}"
+  password           = "%{password}"
+  deletion_policy    = "%{deletionPolicy}"
+  undelete_on_create = true
+
+  name {
+    family_name = "Scott"
+    given_name = "Michael"
+  }
+}
+`, testUserVals)
+}
+
+func testAccResourceUser_adoptExisting(testUserVals map[string]interface{}) string {
+	return testAccResourceUser_basic(testUserVals) + `
+resource "googleworkspace_user" "adopted" {
+  primary_email  = googleworkspace_user.my-new-user.primary_email
+  adopt_existing = true
+
+  name {
+    family_name = "Scott"
+    given_name = "Michael"
+  }
+}
+`
+}
+
+func testAccResourceUser_adoptExistingWithPassword(testUserVals map[string]interface{}) string {
+	return testAccResourceUser_basic(testUserVals) + Nprintf(`
+resource "googleworkspace_user" "adopted" {
+  primary_email  = googleworkspace_user.my-new-user.primary_email
+  password       = "%{password}-adopted"
+  adopt_existing = true
+
+  name {
+    family_name = "Scott"
+    given_name = "Michael"
+  }
+}
+`, testUserVals)
+}
+
+func testAccResourceUser_signOutOnSuspend(testUserVals map[string]interface{}) string {
+	return Nprintf(`
+resource "googleworkspace_user" "my-new-user" {
+  primary_email       = "%{userEmail}@%{domainName}"
+  password            = "%{password}"
+  suspended           = %{suspended}
+  sign_out_on_suspend = true
+
+  name {
+    family_name = "Scott"
+    given_name = "Michael"
+  }
+}
+`, testUserVals)
+}
+
+func testAccResourceUser_updateModePatch(testUserVals map[string]interface{}) string {
+	return Nprintf(`
+resource "googleworkspace_user" "my-new-user" {
+  primary_email = "%{userEmail}@%{domainName}"
+  password      = "%{password}"
+  update_mode   = "PATCH"
+
+  recovery_email = "mscott@example.com"
+
+  name {
+    family_name = "Scott"
+    given_name  = "Michael"
+  }
+
+  phones {
+    type  = "work"
+    value = "555-555-5555"
+  }
+}
+`, testUserVals)
+}
+
+func testAccResourceUser_updateModePatchUnmanaged(testUserVals map[string]interface{}) string {
+	return Nprintf(`
+resource "googleworkspace_user" "my-new-user" {
+  primary_email = "%{userEmail}@%{domainName}"
+  password      = "%{password}"
+  update_mode   = "PATCH"
+
+  name {
+    family_name = "Halpert"
+    given_name  = "Jim"
+  }
+}
+`, testUserVals)
+}
+
+func testAccResourceUser_updateModePatchUnmanagedDataSource(testUserVals map[string]interface{}) string {
+	return testAccResourceUser_updateModePatchUnmanaged(testUserVals) + `
+data "googleworkspace_user" "my-new-user" {
+  primary_email = googleworkspace_user.my-new-user.primary_email
+}
+`
+}
+
+func testAccResourceUser_customSchemasPlanValidationSchema(testUserVals map[string]interface{}) string {
+	return Nprintf(`
+resource "googleworkspace_schema" "my-schema" {
+  schema_name = "%{userEmail}-schema"
+
+  fields {
+    field_name   = "favorite-numbers"
+    field_type   = "INT64"
+    multi_valued = true
+
+    numeric_indexing_spec {
+      min_value = 1
+      max_value = 100
+    }
+  }
+}
+`, testUserVals)
+}
+
+func testAccResourceUser_customSchemasPlanValidation(testUserVals map[string]interface{}) string {
+	return testAccResourceUser_customSchemasPlanValidationSchema(testUserVals) + Nprintf(`
+resource "googleworkspace_user" "my-new-user" {
+  primary_email = "%{userEmail}@%{domainName}"
+  password      = "%{password}"
+
+  name {
+    family_name = "Schrute"
+    given_name  = "Dwight"
+  }
+
+  custom_schemas {
+    schema_name = googleworkspace_schema.my-schema.schema_name
+
+    schema_values = {
+      "favorite-numbers" = jsonencode([1, 200])
+    }
+  }
+}
+`, testUserVals)
+}
+
+func testAccResourceUser_customSchemasMissingSchema(testUserVals map[string]interface{}) string {
+	return testAccResourceUser_customSchemasPlanValidationSchema(testUserVals) + Nprintf(`
+resource "googleworkspace_user" "my-new-user" {
+  primary_email = "%{userEmail}@%{domainName}"
+  password      = "%{password}"
+
+  name {
+    family_name = "Schrute"
+    given_name  = "Dwight"
+  }
+
+  custom_schemas {
+    schema_name = "%{userEmail}-missing"
+
+    schema_values = {
+      "favorite-numbers" = jsonencode([1])
+    }
+  }
+}
+`, testUserVals)
+}
+
 func testAccResourceUser_full(testUserVals map[string]interface{}) string {
 	return Nprintf(`
 resource "googleworkspace_schema" "my-schema" {
@@ -808,3 +1860,26 @@ resource "googleworkspace_user" "my-new-user" {
 }
 `, testUserVals)
 }
+
+func testAccResourceUser_rename(testUserVals map[string]interface{}, suffix string) string {
+	testUserVals["suffix"] = suffix
+	if _, ok := testUserVals["keepOldEmail"]; !ok {
+		testUserVals["keepOldEmail"] = "true"
+	}
+
+	return Nprintf(`
+resource "googleworkspace_user" "my-new-user" {
+  primary_email = "%{userEmail}%{suffix}@%{domainName}"
+  password      = "%{password}"
+
+  name {
+    family_name = "Scott"
+    given_name  = "Michael"
+  }
+
+  aliases = ["%{userEmail}-alias@%{domainName}"]
+
+  keep_old_email_as_alias = %{keepOldEmail}
+}
+`, testUserVals)
+}
@@ -0,0 +1,138 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceUserVerificationCodes() *schema.Resource {
+	return &schema.Resource{
+		Description: "User Verification Codes resource generates backup verification codes for a user's " +
+			"2-Step Verification. The codes are stored in the state, which should be secured accordingly. " +
+			"Changing `triggers` generates new codes, and destroying the resource invalidates the codes. " +
+			"User Verification Codes resides under the `https://www.googleapis.com/auth/admin.directory.user.security` " +
+			"client scope.",
+
+		CreateContext: resourceUserVerificationCodesCreate,
+		ReadContext:   resourceUserVerificationCodesRead,
+		DeleteContext: resourceUserVerificationCodesDelete,
+
+		Schema: map[string]*schema.Schema{
+			"user_id": {
+				Description: "The user's primary email address, alias email address, or unique user ID.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"triggers": {
+				Description: "Arbitrary map of values that, when changed, will generate new verification codes.",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"verification_codes": {
+				Description: "The current, unused backup verification codes of the user.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Sensitive:   true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceUserVerificationCodesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return diags
+	}
+
+	verificationCodesService, diags := GetVerificationCodesService(directoryService)
+	if diags.HasError() {
+		return diags
+	}
+
+	userId := d.Get("user_id").(string)
+	log.Printf("[DEBUG] Generating Verification Codes for user %s", userId)
+
+	err := verificationCodesService.Generate(userId).Do()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(userId)
+
+	log.Printf("[DEBUG] Finished generating Verification Codes for user %s", userId)
+
+	return resourceUserVerificationCodesRead(ctx, d, meta)
+}
+
+func resourceUserVerificationCodesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return diags
+	}
+
+	verificationCodesService, diags := GetVerificationCodesService(directoryService)
+	if diags.HasError() {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Getting Verification Codes for user %s", d.Id())
+
+	verificationCodes, err := verificationCodesService.List(d.Id()).Do()
+	if err != nil {
+		return handleNotFoundError(err, d, d.Id())
+	}
+
+	codes := []string{}
+	for _, code := range verificationCodes.Items {
+		codes = append(codes, code.VerificationCode)
+	}
+
+	d.Set("verification_codes", codes)
+
+	log.Printf("[DEBUG] Finished getting Verification Codes for user %s", d.Id())
+
+	return diags
+}
+
+func resourceUserVerificationCodesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return diags
+	}
+
+	verificationCodesService, diags := GetVerificationCodesService(directoryService)
+	if diags.HasError() {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Invalidating Verification Codes for user %s", d.Id())
+
+	err := verificationCodesService.Invalidate(d.Id()).Do()
+	if err != nil {
+		return handleNotFoundError(err, d, d.Id())
+	}
+
+	log.Printf("[DEBUG] Finished invalidating Verification Codes for user %s", d.Id())
+
+	return diags
+}
@@ -0,0 +1,1020 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mitchellh/go-homedir"
+	directory "google.golang.org/api/admin/directory/v1"
+)
+
+// bulkUserColumns are the accepted CSV columns and JSON object keys of a bulk user source
+var bulkUserColumns = []string{"primary_email", "given_name", "family_name", "org_unit_path", "suspended",
+	"employee_id", "department", "title", "password"}
+
+type bulkUser struct {
+	Row          int    `json:"-"`
+	PrimaryEmail string `json:"primary_email"`
+	GivenName    string `json:"given_name"`
+	FamilyName   string `json:"family_name"`
+	OrgUnitPath  string `json:"org_unit_path"`
+	Suspended    bool   `json:"suspended"`
+	EmployeeId   string `json:"employee_id"`
+	Department   string `json:"department"`
+	Title        string `json:"title"`
+	Password     string `json:"password"`
+}
+
+// bulkUserMaxAttempts and bulkUserInitialBackoff control the retries of rate limited user changes
+var (
+	bulkUserMaxAttempts    = 6
+	bulkUserInitialBackoff = 2 * time.Second
+)
+
+type bulkUserResult struct {
+	user   *bulkUser
+	key    string
+	userId string
+	action string
+	err    error
+}
+
+func resourceUsersBulk() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Users Bulk resource manages a large set of Google Workspace Users as a single resource, " +
+			"e.g. users provisioned from an HR export. The desired users are given either inline in `users` or " +
+			"in a CSV or JSON `source_file`, and are matched against the users of the customer by `key_field`. " +
+			"Users that do not exist are created, users that differ are updated and users that are no longer " +
+			"in the source are handled according to `removal_action`. Each user is changed with its own API " +
+			"request, requests are not batched, and up to `concurrency` requests are sent concurrently. Rate limited requests are retried with an exponential backoff. A " +
+			"failure for one user is reported as a warning for that row without stopping the others, and the " +
+			"user is retried on the next apply as one of the `drifted_keys`.\n\n" +
+			"A CSV source must have a header row, a JSON source must be an array of objects. Both use the " +
+			"following column names / keys: `primary_email` (required), `given_name`, `family_name`, " +
+			"`org_unit_path`, `suspended` (`true`/`false`), `employee_id` (the `organization` external ID), " +
+			"`department` and `title` (of the primary organization), and `password` (only used on creation, " +
+			"a random password is generated and a change is required at next login when empty). " +
+			"Empty values, other than `suspended`, leave the corresponding attribute of an existing user unchanged. " +
+			"Users managed by this resource should not also be managed by `googleworkspace_user`. " +
+			"Users Bulk resides under the `https://www.googleapis.com/auth/admin.directory.user` client scope.",
+
+		CreateContext: resourceUsersBulkCreate,
+		ReadContext:   resourceUsersBulkRead,
+		UpdateContext: resourceUsersBulkUpdate,
+		DeleteContext: resourceUsersBulkDelete,
+
+		CustomizeDiff: resourceUsersBulkCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"users": {
+				Description:  "The desired users. Conflicts with `source_file`.",
+				Type:         schema.TypeList,
+				Optional:     true,
+				ExactlyOneOf: []string{"users", "source_file"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"primary_email": {
+							Description: "The user's primary email address.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"given_name": {
+							Description: "The user's first name. Required when the user is created.",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+						"family_name": {
+							Description: "The user's last name. Required when the user is created.",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+						"org_unit_path": {
+							Description: "The full path of the parent organization associated with the user. " +
+								"Defaults to `/` when the user is created.",
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"suspended": {
+							Description: "Indicates if user is suspended.",
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+						},
+						"employee_id": {
+							Description: "The user's employee ID, stored as the `organization` external ID.",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+						"department": {
+							Description: "The department of the user's primary organization.",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+						"title": {
+							Description: "The title of the user's primary organization.",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+						"password": {
+							Description: "The initial password of the user, only used when the user is created. " +
+								"When empty, a random password is generated and the user must change it at next login.",
+							Type:      schema.TypeString,
+							Optional:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+			"source_file": {
+				Description:  "The path to a CSV or JSON file with the desired users. Conflicts with `users`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"users", "source_file"},
+			},
+			"source_format": {
+				Description: "The format of `source_file`, either `CSV` or `JSON`. " +
+					"Defaults to the format matching the file extension.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				RequiredWith:     []string{"source_file"},
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"CSV", "JSON"}, false)),
+			},
+			"source_sha256": {
+				Description: "The SHA-256 hash of the `source_file` contents, used to detect changes to the file.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"key_field": {
+				Description: "The field used to match the desired users against the existing users of the customer, " +
+					"either `primary_email` or `employee_id`. When matching by `employee_id`, a change of " +
+					"`primary_email` renames the user. Changing it keeps managing the users that have a value for " +
+					"the new key field.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "primary_email",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"primary_email", "employee_id"}, false)),
+			},
+			"removal_action": {
+				Description: "What to do with a managed user that is no longer in the source. Acceptable values are: " +
+					"\n\t- `SUSPEND`: The user is suspended. " +
+					"\n\t- `NONE`: The user is left as is and is no longer managed.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "SUSPEND",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"SUSPEND", "NONE"}, false)),
+			},
+			"suspend_on_destroy": {
+				Description: "If true, all managed users, including the existing users that were matched rather " +
+					"than created, are suspended when this resource is destroyed. Otherwise they are left as is.",
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"concurrency": {
+				Description:      "The maximum number of API requests sent concurrently. Each user is changed with its own request.",
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          50,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(1, 500)),
+			},
+			"managed_users": {
+				Description: "A map of the key of each managed user to its unique ID.",
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"drifted_keys": {
+				Description: "The keys of the desired users that are missing from, or differ from, the customer's users. " +
+					"These users are reconciled on the next apply.",
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			// Adding a computed id simply to override the `optional` id that gets added in the SDK
+			// that will then display improperly in the docs
+			"id": {
+				Description: "The ID of this resource.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceUsersBulkCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] Creating Users Bulk")
+
+	d.SetId(id.UniqueId())
+
+	diags := reconcileUsersBulk(ctx, d, meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Finished creating Users Bulk %q", d.Id())
+
+	return append(diags, resourceUsersBulkRead(ctx, d, meta)...)
+}
+
+func resourceUsersBulkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return diags
+	}
+
+	usersService, diags := GetUsersService(directoryService)
+	if diags.HasError() {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Getting Users Bulk %q", d.Id())
+
+	desired, hash, diags := getDesiredBulkUsers(d)
+	if diags.HasError() {
+		return diags
+	}
+
+	keyField := d.Get("key_field").(string)
+	existing, err := listBulkUsersByKey(ctx, usersService, client.Customer, keyField)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// users deleted outside of terraform are no longer managed
+	managed := map[string]interface{}{}
+	for key, userId := range d.Get("managed_users").(map[string]interface{}) {
+		if user, ok := existing[key]; ok && user.Id == userId.(string) {
+			managed[key] = userId
+		}
+	}
+
+	drifted := []string{}
+	for _, u := range desired {
+		key := bulkUserKey(u, keyField)
+		user, ok := existing[key]
+		if !ok || managed[key] == nil || bulkUserNeedsUpdate(u, user) {
+			drifted = append(drifted, key)
+		}
+	}
+	sort.Strings(drifted)
+
+	if d.Get("source_file").(string) != "" {
+		d.Set("source_sha256", hash)
+	}
+	d.Set("managed_users", managed)
+	d.Set("drifted_keys", drifted)
+
+	log.Printf("[DEBUG] Finished getting Users Bulk %q: %d managed, %d drifted", d.Id(), len(managed), len(drifted))
+
+	return diags
+}
+
+func resourceUsersBulkUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] Updating Users Bulk %q", d.Id())
+
+	diags := reconcileUsersBulk(ctx, d, meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Finished updating Users Bulk %q", d.Id())
+
+	return append(diags, resourceUsersBulkRead(ctx, d, meta)...)
+}
+
+func resourceUsersBulkDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	log.Printf("[DEBUG] Deleting Users Bulk %q", d.Id())
+
+	managed := d.Get("managed_users").(map[string]interface{})
+	if !d.Get("suspend_on_destroy").(bool) || len(managed) == 0 {
+		log.Printf("[DEBUG] Removing Users Bulk %q from state, managed users are left as is", d.Id())
+		return nil
+	}
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return diags
+	}
+
+	usersService, diags := GetUsersService(directoryService)
+	if diags.HasError() {
+		return diags
+	}
+
+	var ops []func() bulkUserResult
+	for key, userId := range managed {
+		ops = append(ops, suspendBulkUserOp(client, usersService, key, userId.(string)))
+	}
+
+	// failures keep the resource in the state, so that destroying it is retried
+	for _, res := range runBulkUserOps(ctx, ops, d.Get("concurrency").(int)) {
+		if res.err != nil && !isNotFound(res.err) {
+			diags = append(diags, bulkUserDiagnostic(res, diag.Error))
+		}
+	}
+
+	log.Printf("[DEBUG] Finished deleting Users Bulk %q", d.Id())
+
+	return diags
+}
+
+func resourceUsersBulkCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	// the managed users are keyed by the new key field on apply
+	if diff.HasChange("key_field") {
+		if err := diff.SetNewComputed("managed_users"); err != nil {
+			return err
+		}
+	}
+
+	if source := diff.Get("source_file").(string); source != "" {
+		contents, err := readBulkUsersSource(source)
+		if err != nil {
+			return err
+		}
+
+		hash := sha256.Sum256(contents)
+		if hex.EncodeToString(hash[:]) != diff.Get("source_sha256").(string) {
+			if err := diff.SetNew("source_sha256", hex.EncodeToString(hash[:])); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := planUsersBulkRemovals(diff, meta); err != nil {
+		return err
+	}
+
+	// drift found while refreshing is planned away, so the users are reconciled on apply
+	if len(diff.Get("drifted_keys").([]interface{})) > 0 {
+		return diff.SetNew("drifted_keys", []interface{}{})
+	}
+
+	return nil
+}
+
+// planUsersBulkRemovals counts the managed users that are no longer desired against the provider's
+// max_deletions_per_apply, so that a plan that suspends too many users fails before anything is suspended.
+// Removals that can't be known during plan are still counted as they happen during apply.
+func planUsersBulkRemovals(diff *schema.ResourceDiff, meta interface{}) error {
+	client, ok := meta.(*apiClient)
+	if !ok || client == nil || diff.Get("removal_action").(string) == "NONE" || diff.HasChange("key_field") {
+		return nil
+	}
+
+	if !diff.NewValueKnown("users") || !diff.NewValueKnown("source_file") {
+		return nil
+	}
+
+	// errors in the source are reported when the users are read or reconciled
+	desired, _, diags := getDesiredBulkUsers(diff)
+	if diags.HasError() {
+		return nil
+	}
+
+	keyField := diff.Get("key_field").(string)
+	desiredKeys := map[string]bool{}
+	for _, u := range desired {
+		desiredKeys[bulkUserKey(u, keyField)] = true
+	}
+
+	removed := []string{}
+	for key := range diff.Get("managed_users").(map[string]interface{}) {
+		if !desiredKeys[key] {
+			removed = append(removed, key)
+		}
+	}
+	sort.Strings(removed)
+
+	return client.deletionGuard.planDeletions("user", removed...)
+}
+
+// reconcileUsersBulk creates, updates and removes users so that the customer's users match the
+// desired users. Failures are reported per user as warnings, so that the resource is not tainted,
+// and managed_users is always updated with the users that were successfully reconciled.
+func reconcileUsersBulk(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	directoryService, diags := client.NewDirectoryService()
+	if diags.HasError() {
+		return diags
+	}
+
+	usersService, diags := GetUsersService(directoryService)
+	if diags.HasError() {
+		return diags
+	}
+
+	desired, _, diags := getDesiredBulkUsers(d)
+	if diags.HasError() {
+		return diags
+	}
+
+	keyField := d.Get("key_field").(string)
+	existing, err := listBulkUsersByKey(ctx, usersService, client.Customer, keyField)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	oldManaged := d.Get("managed_users").(map[string]interface{})
+	if d.HasChange("key_field") {
+		oldManaged = rekeyBulkManagedUsers(oldManaged, existing)
+	}
+	managed := map[string]interface{}{}
+
+	var ops []func() bulkUserResult
+	desiredKeys := map[string]bool{}
+	for _, u := range desired {
+		key := bulkUserKey(u, keyField)
+		desiredKeys[key] = true
+
+		user, ok := existing[key]
+		switch {
+		case !ok:
+			ops = append(ops, createBulkUserOp(usersService, u, key))
+		case bulkUserNeedsUpdate(u, user):
+			ops = append(ops, updateBulkUserOp(usersService, u, key, user))
+		default:
+			managed[key] = user.Id
+		}
+	}
+
+	removalAction := d.Get("removal_action").(string)
+	for key, userId := range oldManaged {
+		if desiredKeys[key] || removalAction == "NONE" {
+			continue
+		}
+
+		if user, ok := existing[key]; !ok || user.Suspended {
+			continue
+		}
+
+		ops = append(ops, suspendBulkUserOp(client, usersService, key, userId.(string)))
+	}
+
+	log.Printf("[DEBUG] Reconciling Users Bulk %q: %d desired users, %d changes", d.Id(), len(desired), len(ops))
+
+	for _, res := range runBulkUserOps(ctx, ops, d.Get("concurrency").(int)) {
+		if res.err != nil {
+			diags = append(diags, bulkUserDiagnostic(res, diag.Warning))
+
+			// users that failed to update are still managed
+			if res.action == "updating" {
+				managed[res.key] = res.userId
+			}
+			continue
+		}
+
+		if res.action != "suspending" {
+			managed[res.key] = res.userId
+		}
+	}
+
+	d.Set("managed_users", managed)
+
+	return diags
+}
+
+// rekeyBulkManagedUsers keys the managed users by the key of the existing users with the same ID.
+// Users without a value for the new key field are no longer managed.
+func rekeyBulkManagedUsers(managed map[string]interface{}, existing map[string]*directory.User) map[string]interface{} {
+	keys := map[string]string{}
+	for key, user := range existing {
+		keys[user.Id] = key
+	}
+
+	result := map[string]interface{}{}
+	for oldKey, userId := range managed {
+		key, ok := keys[userId.(string)]
+		if !ok {
+			log.Printf("[DEBUG] User %q has no value for the new key field, it is no longer managed", oldKey)
+			continue
+		}
+
+		result[key] = userId
+	}
+
+	return result
+}
+
+func createBulkUserOp(usersService *directory.UsersService, u *bulkUser, key string) func() bulkUserResult {
+	return func() bulkUserResult {
+		res := bulkUserResult{user: u, key: key, action: "creating"}
+
+		if u.GivenName == "" || u.FamilyName == "" {
+			res.err = fmt.Errorf("given_name and family_name are required to create a user")
+			return res
+		}
+
+		userObj := expandBulkUser(u, nil)
+		userObj.PrimaryEmail = u.PrimaryEmail
+		userObj.Password = u.Password
+		if userObj.Password == "" {
+			password, err := generatePassword(24)
+			if err != nil {
+				res.err = err
+				return res
+			}
+
+			userObj.Password = password
+			userObj.ChangePasswordAtNextLogin = true
+		}
+		if userObj.OrgUnitPath == "" {
+			userObj.OrgUnitPath = "/"
+		}
+
+		user, err := usersService.Insert(userObj).Do()
+		if err != nil {
+			res.err = err
+			return res
+		}
+
+		res.userId = user.Id
+		return res
+	}
+}
+
+func updateBulkUserOp(usersService *directory.UsersService, u *bulkUser, key string, existing *directory.User) func() bulkUserResult {
+	return func() bulkUserResult {
+		res := bulkUserResult{user: u, key: key, userId: existing.Id, action: "updating"}
+
+		userObj := expandBulkUser(u, existing)
+		if !strings.EqualFold(u.PrimaryEmail, existing.PrimaryEmail) {
+			userObj.PrimaryEmail = u.PrimaryEmail
+		}
+
+		_, err := usersService.Patch(existing.Id, userObj).Do()
+		res.err = err
+
+		return res
+	}
+}
+
+// suspendBulkUserOp suspends the user, unless it is protected by the provider's settings or the provider's
+// max_deletions_per_apply has been reached
+func suspendBulkUserOp(client *apiClient, usersService *directory.UsersService, key, userId string) func() bulkUserResult {
+	return func() bulkUserResult {
+		res := bulkUserResult{key: key, userId: userId, action: "suspending"}
+
+		if err := client.userProtection.checkLiveUser(usersService, "suspend", userId); err != nil {
+			res.err = err
+			return res
+		}
+
+		if err := client.deletionGuard.reserveDeletion("user", key); err != nil {
+			res.err = err
+			return res
+		}
+
+		_, err := usersService.Patch(userId, &directory.User{Suspended: true}).Do()
+		res.err = err
+
+		return res
+	}
+}
+
+// runBulkUserOps runs the operations, with at most concurrency operations running at the same time
+func runBulkUserOps(ctx context.Context, ops []func() bulkUserResult, concurrency int) []bulkUserResult {
+	results := make([]bulkUserResult, len(ops))
+	sem := make(chan struct{}, concurrency)
+
+	var wg sync.WaitGroup
+	for i := range ops {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			results[i] = runBulkUserOp(ctx, ops[i])
+		}(i)
+	}
+	wg.Wait()
+
+	log.Printf("[DEBUG] Finished %d user changes", len(ops))
+
+	return results
+}
+
+// runBulkUserOp runs the operation, backing off exponentially while the API is rate limited
+func runBulkUserOp(ctx context.Context, op func() bulkUserResult) bulkUserResult {
+	backoff := bulkUserInitialBackoff
+
+	for attempt := 1; ; attempt++ {
+		res := op()
+		if rateLimited, _ := isRateLimitExceeded(res.err); !rateLimited || attempt == bulkUserMaxAttempts {
+			return res
+		}
+
+		log.Printf("[DEBUG] Rate limited while %s user %q, retrying in %s", res.action, res.key, backoff)
+
+		select {
+		case <-ctx.Done():
+			return res
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+	}
+}
+
+func bulkUserDiagnostic(res bulkUserResult, severity diag.Severity) diag.Diagnostic {
+	summary := fmt.Sprintf("Error %s user %q", res.action, res.key)
+	if res.user != nil && res.user.Row > 0 {
+		summary = fmt.Sprintf("Error %s user %q (row %d)", res.action, res.key, res.user.Row)
+	}
+
+	return diag.Diagnostic{
+		Severity: severity,
+		Summary:  summary,
+		Detail:   res.err.Error(),
+	}
+}
+
+// expandBulkUser builds the user object to send to the API. Only the non-empty fields are set,
+// existing organizations and external IDs of the user are kept.
+func expandBulkUser(u *bulkUser, existing *directory.User) *directory.User {
+	userObj := &directory.User{
+		OrgUnitPath:     u.OrgUnitPath,
+		Suspended:       u.Suspended,
+		ForceSendFields: []string{"Suspended"},
+	}
+
+	if u.GivenName != "" || u.FamilyName != "" {
+		userObj.Name = &directory.UserName{
+			GivenName:  u.GivenName,
+			FamilyName: u.FamilyName,
+		}
+		if existing != nil && existing.Name != nil {
+			if userObj.Name.GivenName == "" {
+				userObj.Name.GivenName = existing.Name.GivenName
+			}
+			if userObj.Name.FamilyName == "" {
+				userObj.Name.FamilyName = existing.Name.FamilyName
+			}
+		}
+	}
+
+	var existingExternalIds, existingOrganizations interface{}
+	if existing != nil {
+		existingExternalIds = existing.ExternalIds
+		existingOrganizations = existing.Organizations
+	}
+
+	if u.EmployeeId != "" {
+		externalIds := bulkUserObjects(existingExternalIds)
+		found := false
+		for _, externalId := range externalIds {
+			if externalId["type"] == "organization" {
+				externalId["value"] = u.EmployeeId
+				found = true
+				break
+			}
+		}
+		if !found {
+			externalIds = append(externalIds, map[string]interface{}{"type": "organization", "value": u.EmployeeId})
+		}
+		userObj.ExternalIds = externalIds
+	}
+
+	if u.Department != "" || u.Title != "" {
+		organizations := bulkUserObjects(existingOrganizations)
+		primary := primaryBulkUserOrganization(organizations)
+		if primary == nil {
+			primary = map[string]interface{}{"primary": true}
+			organizations = append(organizations, primary)
+		}
+		if u.Department != "" {
+			primary["department"] = u.Department
+		}
+		if u.Title != "" {
+			primary["title"] = u.Title
+		}
+		userObj.Organizations = organizations
+	}
+
+	return userObj
+}
+
+// bulkUserNeedsUpdate returns whether any of the non-empty fields of the desired user differ from the existing user
+func bulkUserNeedsUpdate(u *bulkUser, user *directory.User) bool {
+	if !strings.EqualFold(u.PrimaryEmail, user.PrimaryEmail) || u.Suspended != user.Suspended {
+		return true
+	}
+
+	if u.OrgUnitPath != "" && u.OrgUnitPath != user.OrgUnitPath {
+		return true
+	}
+
+	if user.Name != nil {
+		if (u.GivenName != "" && u.GivenName != user.Name.GivenName) ||
+			(u.FamilyName != "" && u.FamilyName != user.Name.FamilyName) {
+			return true
+		}
+	}
+
+	if u.EmployeeId != "" && u.EmployeeId != bulkUserEmployeeId(user) {
+		return true
+	}
+
+	if u.Department != "" || u.Title != "" {
+		primary := primaryBulkUserOrganization(bulkUserObjects(user.Organizations))
+		if primary == nil {
+			return true
+		}
+		if (u.Department != "" && u.Department != primary["department"]) ||
+			(u.Title != "" && u.Title != primary["title"]) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func bulkUserKey(u *bulkUser, keyField string) string {
+	if keyField == "employee_id" {
+		return u.EmployeeId
+	}
+
+	return strings.ToLower(u.PrimaryEmail)
+}
+
+func bulkUserEmployeeId(user *directory.User) string {
+	for _, externalId := range bulkUserObjects(user.ExternalIds) {
+		if externalId["type"] == "organization" {
+			if value, ok := externalId["value"].(string); ok {
+				return value
+			}
+		}
+	}
+
+	return ""
+}
+
+// bulkUserObjects converts a list of objects as returned by the API, e.g. external IDs or organizations
+func bulkUserObjects(v interface{}) []map[string]interface{} {
+	result := []map[string]interface{}{}
+
+	objList, ok := v.([]interface{})
+	if !ok {
+		return result
+	}
+
+	for _, o := range objList {
+		if obj, ok := o.(map[string]interface{}); ok {
+			result = append(result, obj)
+		}
+	}
+
+	return result
+}
+
+func primaryBulkUserOrganization(organizations []map[string]interface{}) map[string]interface{} {
+	for _, org := range organizations {
+		if primary, ok := org["primary"].(bool); ok && primary {
+			return org
+		}
+	}
+
+	if len(organizations) > 0 {
+		return organizations[0]
+	}
+
+	return nil
+}
+
+// listBulkUsersByKey returns all users of the customer, keyed by the given key field
+func listBulkUsersByKey(ctx context.Context, usersService *directory.UsersService, customer, keyField string) (map[string]*directory.User, error) {
+	result := map[string]*directory.User{}
+
+	err := usersService.List().Customer(customer).Projection("basic").MaxResults(500).Pages(ctx, func(resp *directory.Users) error {
+		for _, user := range resp.Users {
+			key := strings.ToLower(user.PrimaryEmail)
+			if keyField == "employee_id" {
+				key = bulkUserEmployeeId(user)
+			}
+
+			if key != "" {
+				result[key] = user
+			}
+		}
+
+		return nil
+	})
+
+	return result, err
+}
+
+// bulkUsersGetter is implemented by both schema.ResourceData and schema.ResourceDiff
+type bulkUsersGetter interface {
+	Get(key string) interface{}
+}
+
+// getDesiredBulkUsers returns the desired users from either the users or source_file attribute,
+// along with the SHA-256 hash of the source file
+func getDesiredBulkUsers(d bulkUsersGetter) ([]*bulkUser, string, diag.Diagnostics) {
+	var users []*bulkUser
+	var hash string
+
+	if source := d.Get("source_file").(string); source != "" {
+		contents, err := readBulkUsersSource(source)
+		if err != nil {
+			return nil, "", diag.FromErr(err)
+		}
+
+		sum := sha256.Sum256(contents)
+		hash = hex.EncodeToString(sum[:])
+
+		format := d.Get("source_format").(string)
+		if format == "" {
+			format = strings.ToUpper(strings.TrimPrefix(filepath.Ext(source), "."))
+		}
+
+		switch format {
+		case "CSV":
+			users, err = parseBulkUsersCSV(contents)
+		case "JSON":
+			users, err = parseBulkUsersJSON(contents)
+		default:
+			err = fmt.Errorf("could not determine the format of %s, set source_format to CSV or JSON", source)
+		}
+		if err != nil {
+			return nil, "", diag.FromErr(err)
+		}
+	} else {
+		users = expandBulkUsers(d.Get("users").([]interface{}))
+	}
+
+	keyField := d.Get("key_field").(string)
+	if err := validateBulkUsers(users, keyField); err != nil {
+		return nil, "", diag.FromErr(err)
+	}
+
+	return users, hash, nil
+}
+
+func readBulkUsersSource(source string) ([]byte, error) {
+	path, err := homedir.Expand(source)
+	if err != nil {
+		return nil, err
+	}
+
+	return os.ReadFile(path)
+}
+
+func expandBulkUsers(v []interface{}) []*bulkUser {
+	users := []*bulkUser{}
+
+	for i, raw := range v {
+		u := raw.(map[string]interface{})
+		users = append(users, &bulkUser{
+			Row:          i + 1,
+			PrimaryEmail: u["primary_email"].(string),
+			GivenName:    u["given_name"].(string),
+			FamilyName:   u["family_name"].(string),
+			OrgUnitPath:  u["org_unit_path"].(string),
+			Suspended:    u["suspended"].(bool),
+			EmployeeId:   u["employee_id"].(string),
+			Department:   u["department"].(string),
+			Title:        u["title"].(string),
+			Password:     u["password"].(string),
+		})
+	}
+
+	return users
+}
+
+func parseBulkUsersCSV(contents []byte) ([]*bulkUser, error) {
+	reader := csv.NewReader(bytes.NewReader(contents))
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("error reading CSV header: %v", err)
+	}
+
+	columns := map[string]int{}
+	for i, column := range header {
+		column = strings.ToLower(strings.TrimSpace(column))
+		if !stringInSlice(bulkUserColumns, column) {
+			return nil, fmt.Errorf("unknown CSV column %q, acceptable columns are: %s", column, strings.Join(bulkUserColumns, ", "))
+		}
+		columns[column] = i
+	}
+
+	if _, ok := columns["primary_email"]; !ok {
+		return nil, fmt.Errorf("CSV is missing the primary_email column")
+	}
+
+	users := []*bulkUser{}
+	// row numbers are line numbers in the file, the header being row 1
+	for row := 2; ; row++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		value := func(column string) string {
+			if i, ok := columns[column]; ok {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+
+		u := &bulkUser{
+			Row:          row,
+			PrimaryEmail: value("primary_email"),
+			GivenName:    value("given_name"),
+			FamilyName:   value("family_name"),
+			OrgUnitPath:  value("org_unit_path"),
+			EmployeeId:   value("employee_id"),
+			Department:   value("department"),
+			Title:        value("title"),
+			Password:     value("password"),
+		}
+
+		if suspended := value("suspended"); suspended != "" {
+			u.Suspended, err = strconv.ParseBool(suspended)
+			if err != nil {
+				return nil, fmt.Errorf("row %d: invalid suspended value %q", row, suspended)
+			}
+		}
+
+		users = append(users, u)
+	}
+
+	return users, nil
+}
+
+func parseBulkUsersJSON(contents []byte) ([]*bulkUser, error) {
+	decoder := json.NewDecoder(bytes.NewReader(contents))
+	decoder.DisallowUnknownFields()
+
+	users := []*bulkUser{}
+	if err := decoder.Decode(&users); err != nil {
+		return nil, fmt.Errorf("error decoding JSON users: %v", err)
+	}
+
+	// row numbers are the 1-based index in the array
+	for i, u := range users {
+		u.Row = i + 1
+	}
+
+	return users, nil
+}
+
+func validateBulkUsers(users []*bulkUser, keyField string) error {
+	seen := map[string]int{}
+
+	for _, u := range users {
+		if !isEmail(u.PrimaryEmail) {
+			return fmt.Errorf("row %d: %q is not a valid primary_email", u.Row, u.PrimaryEmail)
+		}
+
+		key := bulkUserKey(u, keyField)
+		if key == "" {
+			return fmt.Errorf("row %d: %s is required", u.Row, keyField)
+		}
+
+		if row, ok := seen[key]; ok {
+			return fmt.Errorf("row %d: duplicate %s %q, also in row %d", u.Row, keyField, key, row)
+		}
+		seen[key] = u.Row
+	}
+
+	return nil
+}
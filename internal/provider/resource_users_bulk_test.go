@@ -0,0 +1,393 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package googleworkspace
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	directory "google.golang.org/api/admin/directory/v1"
+	"google.golang.org/api/googleapi"
+)
+
+func TestResourceUsersBulk_parseCSV(t *testing.T) {
+	contents := []byte("primary_email,given_name,family_name,suspended,employee_id\n" +
+		"jim@example.com,Jim,Halpert,false,100\n" +
+		"dwight@example.com, Dwight ,Schrute,true,101\n")
+
+	users, err := parseBulkUsersCSV(contents)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(users) != 2 {
+		t.Fatalf("expected 2 users, got %d", len(users))
+	}
+
+	if users[1].Row != 3 || users[1].GivenName != "Dwight" || !users[1].Suspended || users[1].EmployeeId != "101" {
+		t.Errorf("unexpected user parsed: %#v", users[1])
+	}
+
+	if _, err := parseBulkUsersCSV([]byte("primary_email,nickname\njim@example.com,Jimbo\n")); err == nil {
+		t.Errorf("expected an error for an unknown column")
+	}
+
+	if _, err := parseBulkUsersCSV([]byte("given_name\nJim\n")); err == nil {
+		t.Errorf("expected an error for a missing primary_email column")
+	}
+
+	if _, err := parseBulkUsersCSV([]byte("primary_email,suspended\njim@example.com,maybe\n")); err == nil {
+		t.Errorf("expected an error for an invalid suspended value")
+	}
+}
+
+func TestResourceUsersBulk_parseJSON(t *testing.T) {
+	contents := []byte(`[
+  {"primary_email": "jim@example.com", "given_name": "Jim", "family_name": "Halpert"},
+  {"primary_email": "dwight@example.com", "suspended": true, "department": "Sales"}
+]`)
+
+	users, err := parseBulkUsersJSON(contents)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(users) != 2 {
+		t.Fatalf("expected 2 users, got %d", len(users))
+	}
+
+	if users[1].Row != 2 || !users[1].Suspended || users[1].Department != "Sales" {
+		t.Errorf("unexpected user parsed: %#v", users[1])
+	}
+
+	if _, err := parseBulkUsersJSON([]byte(`[{"primary_email": "jim@example.com", "nickname": "Jimbo"}]`)); err == nil {
+		t.Errorf("expected an error for an unknown key")
+	}
+}
+
+func TestResourceUsersBulk_validate(t *testing.T) {
+	users := []*bulkUser{
+		{Row: 1, PrimaryEmail: "jim@example.com"},
+		{Row: 2, PrimaryEmail: "JIM@example.com"},
+	}
+
+	if err := validateBulkUsers(users, "primary_email"); err == nil {
+		t.Errorf("expected an error for duplicate primary emails")
+	}
+
+	if err := validateBulkUsers(users, "employee_id"); err == nil {
+		t.Errorf("expected an error for missing employee IDs")
+	}
+
+	users[0].EmployeeId = "100"
+	users[1].EmployeeId = "101"
+	if err := validateBulkUsers(users, "employee_id"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestResourceUsersBulk_needsUpdate(t *testing.T) {
+	user := &directory.User{
+		PrimaryEmail: "jim@example.com",
+		OrgUnitPath:  "/sales",
+		Name: &directory.UserName{
+			GivenName:  "Jim",
+			FamilyName: "Halpert",
+		},
+		ExternalIds: []interface{}{
+			map[string]interface{}{"type": "organization", "value": "100"},
+		},
+		Organizations: []interface{}{
+			map[string]interface{}{"primary": true, "department": "Sales", "title": "Salesman"},
+		},
+	}
+
+	cases := []struct {
+		name     string
+		u        *bulkUser
+		expected bool
+	}{
+		{"unchanged", &bulkUser{PrimaryEmail: "JIM@example.com", GivenName: "Jim", EmployeeId: "100", Title: "Salesman"}, false},
+		{"empty values unmanaged", &bulkUser{PrimaryEmail: "jim@example.com"}, false},
+		{"suspended", &bulkUser{PrimaryEmail: "jim@example.com", Suspended: true}, true},
+		{"org unit", &bulkUser{PrimaryEmail: "jim@example.com", OrgUnitPath: "/"}, true},
+		{"family name", &bulkUser{PrimaryEmail: "jim@example.com", FamilyName: "Beesly"}, true},
+		{"employee id", &bulkUser{PrimaryEmail: "jim@example.com", EmployeeId: "101"}, true},
+		{"department", &bulkUser{PrimaryEmail: "jim@example.com", Department: "Management"}, true},
+		{"primary email", &bulkUser{PrimaryEmail: "jim.halpert@example.com"}, true},
+	}
+
+	for _, tc := range cases {
+		if got := bulkUserNeedsUpdate(tc.u, user); got != tc.expected {
+			t.Errorf("%s: expected %t, got %t", tc.name, tc.expected, got)
+		}
+	}
+}
+
+func TestResourceUsersBulk_expand(t *testing.T) {
+	existing := &directory.User{
+		Name: &directory.UserName{
+			GivenName:  "Jim",
+			FamilyName: "Halpert",
+		},
+		ExternalIds: []interface{}{
+			map[string]interface{}{"type": "custom", "customType": "badge", "value": "42"},
+		},
+		Organizations: []interface{}{
+			map[string]interface{}{"primary": true, "name": "Dunder Mifflin", "title": "Salesman"},
+		},
+	}
+
+	userObj := expandBulkUser(&bulkUser{FamilyName: "Beesly", EmployeeId: "100", Department: "Sales"}, existing)
+
+	if userObj.Name.GivenName != "Jim" || userObj.Name.FamilyName != "Beesly" {
+		t.Errorf("unexpected name: %#v", userObj.Name)
+	}
+
+	externalIds := userObj.ExternalIds.([]map[string]interface{})
+	if len(externalIds) != 2 || externalIds[1]["value"] != "100" {
+		t.Errorf("unexpected external ids: %#v", externalIds)
+	}
+
+	organizations := userObj.Organizations.([]map[string]interface{})
+	if len(organizations) != 1 || organizations[0]["name"] != "Dunder Mifflin" || organizations[0]["department"] != "Sales" {
+		t.Errorf("unexpected organizations: %#v", organizations)
+	}
+}
+
+func TestResourceUsersBulk_rekeyManagedUsers(t *testing.T) {
+	managed := map[string]interface{}{
+		"jim@example.com":    "1",
+		"dwight@example.com": "2",
+	}
+
+	existing := map[string]*directory.User{
+		"100": {Id: "1", PrimaryEmail: "jim@example.com"},
+		"300": {Id: "3", PrimaryEmail: "pam@example.com"},
+	}
+
+	rekeyed := rekeyBulkManagedUsers(managed, existing)
+	if len(rekeyed) != 1 || rekeyed["100"] != "1" {
+		t.Errorf("expected only jim to be managed by employee id, got %v", rekeyed)
+	}
+}
+
+func TestResourceUsersBulk_runOpRateLimited(t *testing.T) {
+	initialBackoff := bulkUserInitialBackoff
+	bulkUserInitialBackoff = time.Millisecond
+	defer func() { bulkUserInitialBackoff = initialBackoff }()
+
+	attempts := 0
+	res := runBulkUserOp(context.Background(), func() bulkUserResult {
+		attempts++
+		if attempts < 3 {
+			return bulkUserResult{action: "creating", err: &googleapi.Error{Code: 429}}
+		}
+		return bulkUserResult{action: "creating"}
+	})
+	if res.err != nil || attempts != 3 {
+		t.Errorf("expected the rate limited operation to succeed after 3 attempts, got %d attempts: %v", attempts, res.err)
+	}
+
+	attempts = 0
+	res = runBulkUserOp(context.Background(), func() bulkUserResult {
+		attempts++
+		return bulkUserResult{action: "creating", err: &googleapi.Error{Code: 400}}
+	})
+	if res.err == nil || attempts != 1 {
+		t.Errorf("expected other errors not to be retried, got %d attempts", attempts)
+	}
+}
+
+func TestResourceUsersBulk_runOpsConcurrency(t *testing.T) {
+	var mu sync.Mutex
+	running, maxRunning := 0, 0
+
+	ops := []func() bulkUserResult{}
+	for i := 0; i < 20; i++ {
+		key := fmt.Sprintf("user-%d", i)
+		ops = append(ops, func() bulkUserResult {
+			mu.Lock()
+			running++
+			if running > maxRunning {
+				maxRunning = running
+			}
+			mu.Unlock()
+
+			time.Sleep(time.Millisecond)
+
+			mu.Lock()
+			running--
+			mu.Unlock()
+
+			return bulkUserResult{key: key}
+		})
+	}
+
+	results := runBulkUserOps(context.Background(), ops, 3)
+	if maxRunning > 3 {
+		t.Errorf("expected at most 3 concurrent operations, got %d", maxRunning)
+	}
+
+	for i, res := range results {
+		if res.key != fmt.Sprintf("user-%d", i) {
+			t.Errorf("expected the result of user-%d at %d, got %s", i, i, res.key)
+		}
+	}
+}
+
+func TestAccResourceUsersBulk_basic(t *testing.T) {
+	t.Parallel()
+
+	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+
+	if domainName == "" {
+		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
+	}
+
+	testUserVals := map[string]interface{}{
+		"domainName": domainName,
+		"userEmail":  fmt.Sprintf("tf-test-%s", acctest.RandString(10)),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceUsersBulk(testUserVals),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_users_bulk.test", "managed_users.%", "2"),
+					resource.TestCheckResourceAttr("googleworkspace_users_bulk.test", "drifted_keys.#", "0"),
+				),
+			},
+			{
+				Config: testAccResourceUsersBulk_removed(testUserVals),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_users_bulk.test", "managed_users.%", "1"),
+					resource.TestCheckResourceAttr("googleworkspace_users_bulk.test", "drifted_keys.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceUsersBulk_sourceFile(t *testing.T) {
+	t.Parallel()
+
+	domainName := os.Getenv("GOOGLEWORKSPACE_DOMAIN")
+
+	if domainName == "" {
+		t.Skip("GOOGLEWORKSPACE_DOMAIN needs to be set to run this test")
+	}
+
+	userEmail := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	sourceFile := filepath.Join(t.TempDir(), "users.csv")
+
+	writeSource := func(title string) func() {
+		return func() {
+			contents := fmt.Sprintf("primary_email,given_name,family_name,employee_id,title\n"+
+				"%s@%s,Jim,Halpert,%s,%s\n", userEmail, domainName, acctest.RandString(8), title)
+			if err := os.WriteFile(sourceFile, []byte(contents), 0600); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	testUserVals := map[string]interface{}{
+		"sourceFile": sourceFile,
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: writeSource("Salesman"),
+				Config:    testAccResourceUsersBulk_sourceFile(testUserVals),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_users_bulk.test", "managed_users.%", "1"),
+					resource.TestCheckResourceAttrSet("googleworkspace_users_bulk.test", "source_sha256"),
+				),
+			},
+			{
+				PreConfig: writeSource("Assistant Regional Manager"),
+				Config:    testAccResourceUsersBulk_sourceFile(testUserVals),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_users_bulk.test", "managed_users.%", "1"),
+					resource.TestCheckResourceAttr("googleworkspace_users_bulk.test", "drifted_keys.#", "0"),
+				),
+			},
+			{
+				// the user keeps being managed when matched by employee ID
+				Config: testAccResourceUsersBulk_sourceFileByEmployeeId(testUserVals),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("googleworkspace_users_bulk.test", "managed_users.%", "1"),
+					resource.TestCheckResourceAttr("googleworkspace_users_bulk.test", "drifted_keys.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceUsersBulk(testUserVals map[string]interface{}) string {
+	return Nprintf(`
+resource "googleworkspace_users_bulk" "test" {
+  users {
+    primary_email = "%{userEmail}-jim@%{domainName}"
+    given_name    = "Jim"
+    family_name   = "Halpert"
+    department    = "Sales"
+  }
+
+  users {
+    primary_email = "%{userEmail}-dwight@%{domainName}"
+    given_name    = "Dwight"
+    family_name   = "Schrute"
+    title         = "Assistant to the Regional Manager"
+  }
+
+  suspend_on_destroy = true
+}
+`, testUserVals)
+}
+
+func testAccResourceUsersBulk_removed(testUserVals map[string]interface{}) string {
+	return Nprintf(`
+resource "googleworkspace_users_bulk" "test" {
+  users {
+    primary_email = "%{userEmail}-jim@%{domainName}"
+    given_name    = "Jim"
+    family_name   = "Halpert"
+    department    = "Sales"
+  }
+
+  suspend_on_destroy = true
+}
+`, testUserVals)
+}
+
+func testAccResourceUsersBulk_sourceFile(testUserVals map[string]interface{}) string {
+	return Nprintf(`
+resource "googleworkspace_users_bulk" "test" {
+  source_file = "%{sourceFile}"
+}
+`, testUserVals)
+}
+
+func testAccResourceUsersBulk_sourceFileByEmployeeId(testUserVals map[string]interface{}) string {
+	return Nprintf(`
+resource "googleworkspace_users_bulk" "test" {
+  source_file = "%{sourceFile}"
+  key_field   = "employee_id"
+}
+`, testUserVals)
+}
@@ -10,12 +10,30 @@ import (
 
 	datatransfer "google.golang.org/api/admin/datatransfer/v1"
 	directory "google.golang.org/api/admin/directory/v1"
+	"google.golang.org/api/chromemanagement/v1"
 	"google.golang.org/api/chromepolicy/v1"
 	"google.golang.org/api/cloudidentity/v1"
 	"google.golang.org/api/gmail/v1"
 	"google.golang.org/api/groupssettings/v1"
 )
 
+func GetChromeManagementAppsService(chromeManagementService *chromemanagement.Service) (*chromemanagement.CustomersAppsService, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	log.Printf("[INFO] Instantiating Google Admin Chrome Management Apps service")
+	customersService := chromeManagementService.Customers
+	if customersService == nil || customersService.Apps == nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Chrome Management Apps Service could not be created.",
+		})
+
+		return nil, diags
+	}
+
+	return customersService.Apps, diags
+}
+
 func GetChromePoliciesService(chromePolicyService *chromepolicy.Service) (*chromepolicy.CustomersPoliciesService, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
@@ -50,6 +68,57 @@ func GetChromePolicySchemasService(chromePolicyService *chromepolicy.Service) (*
 	return customersService.PolicySchemas, diags
 }
 
+func GetChromePolicyMediaService(chromePolicyService *chromepolicy.Service) (*chromepolicy.MediaService, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	log.Printf("[INFO] Instantiating Google Admin Chrome Policy Media service")
+	mediaService := chromePolicyService.Media
+	if mediaService == nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Chrome Policy Media Service could not be created.",
+		})
+
+		return nil, diags
+	}
+
+	return mediaService, diags
+}
+
+func GetChromePrintersService(directoryService *directory.Service) (*directory.CustomersChromePrintersService, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	log.Printf("[INFO] Instantiating Google Admin Chrome Printers service")
+	customersService := directoryService.Customers
+	if customersService == nil || customersService.Chrome == nil || customersService.Chrome.Printers == nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Chrome Printers Service could not be created.",
+		})
+
+		return nil, diags
+	}
+
+	return customersService.Chrome.Printers, diags
+}
+
+func GetChromePrintServersService(directoryService *directory.Service) (*directory.CustomersChromePrintServersService, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	log.Printf("[INFO] Instantiating Google Admin Chrome Print Servers service")
+	customersService := directoryService.Customers
+	if customersService == nil || customersService.Chrome == nil || customersService.Chrome.PrintServers == nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Chrome Print Servers Service could not be created.",
+		})
+
+		return nil, diags
+	}
+
+	return customersService.Chrome.PrintServers, diags
+}
+
 func GetDomainAliasesService(directoryService *directory.Service) (*directory.DomainAliasesService, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
@@ -288,6 +357,91 @@ func GetUsersService(directoryService *directory.Service) (*directory.UsersServi
 	return usersService, diags
 }
 
+func GetUserPhotosService(usersService *directory.UsersService) (*directory.UsersPhotosService, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	log.Printf("[INFO] Instantiating Google Admin User Photos service")
+	photosService := usersService.Photos
+	if photosService == nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Users Photos Service could not be created.",
+		})
+
+		return nil, diags
+	}
+
+	return photosService, diags
+}
+
+func GetAspsService(directoryService *directory.Service) (*directory.AspsService, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	log.Printf("[INFO] Instantiating Google Admin Asps service")
+	aspsService := directoryService.Asps
+	if aspsService == nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Asps Service could not be created.",
+		})
+
+		return nil, diags
+	}
+
+	return aspsService, diags
+}
+
+func GetTokensService(directoryService *directory.Service) (*directory.TokensService, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	log.Printf("[INFO] Instantiating Google Admin Tokens service")
+	tokensService := directoryService.Tokens
+	if tokensService == nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Tokens Service could not be created.",
+		})
+
+		return nil, diags
+	}
+
+	return tokensService, diags
+}
+
+func GetVerificationCodesService(directoryService *directory.Service) (*directory.VerificationCodesService, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	log.Printf("[INFO] Instantiating Google Admin Verification Codes service")
+	verificationCodesService := directoryService.VerificationCodes
+	if verificationCodesService == nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Verification Codes Service could not be created.",
+		})
+
+		return nil, diags
+	}
+
+	return verificationCodesService, diags
+}
+
+func GetTwoStepVerificationService(directoryService *directory.Service) (*directory.TwoStepVerificationService, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	log.Printf("[INFO] Instantiating Google Admin Two Step Verification service")
+	twoStepVerificationService := directoryService.TwoStepVerification
+	if twoStepVerificationService == nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Two Step Verification Service could not be created.",
+		})
+
+		return nil, diags
+	}
+
+	return twoStepVerificationService, diags
+}
+
 func GetUserAliasService(usersService *directory.UsersService) (*directory.UsersAliasesService, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
@@ -73,6 +73,21 @@ func handleNotFoundError(err error, d *schema.ResourceData, resource string) dia
 	return diag.Errorf("Error when reading or editing %s: %s", resource, err.Error())
 }
 
+// adoptionConflictsWarning warns that adopting an existing resource overwrites the given fields
+func adoptionConflictsWarning(resourceType, name string, conflicts []string) diag.Diagnostics {
+	if len(conflicts) == 0 {
+		return nil
+	}
+
+	return diag.Diagnostics{
+		{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Adopting the existing %s %s overwrote some of its fields", resourceType, name),
+			Detail:   fmt.Sprintf("The following fields differed from the configuration: %s", strings.Join(conflicts, ", ")),
+		},
+	}
+}
+
 // This is a Printf sibling (Nprintf; Named Printf), which handles strings like
 // Nprintf("Hello %{target}!", map[string]interface{}{"target":"world"}) == "Hello world!".
 // This is particularly useful for generated tests, where we don't want to use Printf,
@@ -141,28 +156,6 @@ func expandInterfaceObjects(parent interface{}) []interface{} {
 	return newObjList
 }
 
-// For resources that have many nested interfaces, we can set was was returned from the API as is
-// only the field names need to be snake case rather than the camel case that is returned
-func flattenInterfaceObjects(objList interface{}) interface{} {
-	if objList == nil || len(objList.([]interface{})) == 0 {
-		return nil
-	}
-
-	newObjList := []map[string]interface{}{}
-
-	for _, o := range objList.([]interface{}) {
-		obj := o.(map[string]interface{})
-		for k, v := range obj {
-			delete(obj, k)
-			obj[CameltoSnake(k)] = v
-		}
-
-		newObjList = append(newObjList, obj)
-	}
-
-	return newObjList
-}
-
 // Converts a list of interfaces to a list of strings
 func listOfInterfacestoStrings(v interface{}) []string {
 	result := []string{}